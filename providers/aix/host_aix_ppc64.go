@@ -46,9 +46,14 @@ import (
 
 func init() {
 	registry.Register(aixSystem{})
+	registry.Register(func(opts registry.ProviderOptions) registry.ProcessProvider {
+		return aixSystem{redactor: opts.Redactor}
+	})
 }
 
-type aixSystem struct{}
+type aixSystem struct {
+	redactor *types.Redactor
+}
 
 // Host returns a new AIX host.
 func (aixSystem) Host() (types.Host, error) {
@@ -47,7 +47,7 @@ import (
 )
 
 // Processes returns a list of all actives processes.
-func (aixSystem) Processes() ([]types.Process, error) {
+func (s aixSystem) Processes() ([]types.Process, error) {
 	// Retrieve processes using /proc instead of calling
 	// getprocs which will also retrieve kernel threads.
 	files, err := os.ReadDir("/proc")
@@ -62,7 +62,7 @@ func (aixSystem) Processes() ([]types.Process, error) {
 		// directories (/proc/pid directory but without any "as" file)
 		if _, err := os.Stat("/proc/" + f.Name() + "/as"); err == nil {
 			pid, _ := strconv.Atoi(f.Name())
-			processes = append(processes, &process{pid: pid})
+			processes = append(processes, &process{pid: pid, redactor: s.redactor})
 		}
 	}
 
@@ -70,8 +70,8 @@ func (aixSystem) Processes() ([]types.Process, error) {
 }
 
 // Process returns the process designed by PID.
-func (aixSystem) Process(pid int) (types.Process, error) {
-	p := process{pid: pid}
+func (s aixSystem) Process(pid int) (types.Process, error) {
+	p := process{pid: pid, redactor: s.redactor}
 	return &p, nil
 }
 
@@ -81,9 +81,10 @@ func (s aixSystem) Self() (types.Process, error) {
 }
 
 type process struct {
-	pid  int
-	info *types.ProcessInfo
-	env  map[string]string
+	pid      int
+	info     *types.ProcessInfo
+	env      map[string]string
+	redactor *types.Redactor
 }
 
 // PID returns the PID of a process.
@@ -97,7 +98,7 @@ func (p *process) Parent() (types.Process, error) {
 	if err != nil {
 		return nil, err
 	}
-	return &process{pid: info.PPID}, nil
+	return &process{pid: info.PPID, redactor: p.redactor}, nil
 }
 
 // Info returns all information about the process.
@@ -175,7 +176,7 @@ func (p *process) Info() (types.ProcessInfo, error) {
 		// For the moment, let's "exe" be the same as "name"
 		p.info.Exe = p.info.Name
 	}
-	p.info.Args = args
+	p.info.Args = p.redactor.Args(args)
 
 	// Get CWD
 	cwd, err := os.Readlink("/proc/" + strconv.Itoa(p.pid) + "/cwd")
@@ -226,6 +227,7 @@ func (p *process) Environment() (map[string]string, error) {
 		p.env[string(pair[0])] = string(pair[1])
 	}
 
+	p.env = p.redactor.Environment(p.env)
 	return p.env, nil
 }
 
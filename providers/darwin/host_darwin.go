@@ -33,9 +33,14 @@ import (
 
 func init() {
 	registry.Register(darwinSystem{})
+	registry.Register(func(opts registry.ProviderOptions) registry.ProcessProvider {
+		return darwinSystem{redactor: opts.Redactor}
+	})
 }
 
-type darwinSystem struct{}
+type darwinSystem struct {
+	redactor *types.Redactor
+}
 
 func (s darwinSystem) Host() (types.Host, error) {
 	return newHost()
@@ -51,7 +51,8 @@ func (s darwinSystem) Processes() ([]types.Process, error) {
 		}
 
 		processes = append(processes, &process{
-			pid: int(pid),
+			pid:      int(pid),
+			redactor: s.redactor,
 		})
 	}
 
@@ -59,7 +60,7 @@ func (s darwinSystem) Processes() ([]types.Process, error) {
 }
 
 func (s darwinSystem) Process(pid int) (types.Process, error) {
-	p := process{pid: pid}
+	p := process{pid: pid, redactor: s.redactor}
 
 	return &p, nil
 }
@@ -69,12 +70,13 @@ func (s darwinSystem) Self() (types.Process, error) {
 }
 
 type process struct {
-	info *types.ProcessInfo
-	pid  int
-	cwd  string
-	exe  string
-	args []string
-	env  map[string]string
+	info     *types.ProcessInfo
+	pid      int
+	cwd      string
+	exe      string
+	args     []string
+	env      map[string]string
+	redactor *types.Redactor
 }
 
 func (p *process) PID() int {
@@ -87,7 +89,7 @@ func (p *process) Parent() (types.Process, error) {
 		return nil, err
 	}
 
-	return &process{pid: info.PPID}, nil
+	return &process{pid: info.PPID, redactor: p.redactor}, nil
 }
 
 func (p *process) Info() (types.ProcessInfo, error) {
@@ -115,7 +117,7 @@ func (p *process) Info() (types.ProcessInfo, error) {
 		PPID: int(task.Pbsd.Pbi_ppid),
 		CWD:  int8SliceToString(vnode.Cdir.Path[:]),
 		Exe:  p.exe,
-		Args: p.args,
+		Args: p.redactor.Args(p.args),
 		StartTime: time.Unix(int64(task.Pbsd.Pbi_start_tvsec),
 			int64(task.Pbsd.Pbi_start_tvusec)*int64(time.Microsecond)),
 	}
@@ -145,7 +147,7 @@ func (p *process) User() (types.UserInfo, error) {
 }
 
 func (p *process) Environment() (map[string]string, error) {
-	return p.env, nil
+	return p.redactor.Environment(p.env), nil
 }
 
 func (p *process) CPUTime() (types.CPUTimes, error) {
@@ -0,0 +1,146 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package linux
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/elastic/go-sysinfo/types"
+)
+
+const sysFirmwarePath = "sys/firmware"
+
+// readBootInfo reads the boot ID, kernel command line and firmware type.
+// Sources that do not exist (e.g. when /sys is not available in the host
+// filesystem) are skipped.
+func readBootInfo(fs procFS) (*types.BootInfo, error) {
+	var boot types.BootInfo
+
+	id, err := fs.readBootID()
+	if err != nil {
+		return nil, err
+	}
+	boot.ID = id
+
+	cmdline, err := os.ReadFile(fs.path("cmdline"))
+	if err != nil && !os.IsNotExist(err) {
+		return nil, fmt.Errorf("failed to read kernel command line: %w", err)
+	}
+	boot.KernelCmdline = parseKernelCmdline(string(cmdline))
+	boot.KernelImage, _ = boot.KernelCmdline.Lookup("BOOT_IMAGE")
+
+	boot.Firmware = readFirmwareType(fs.rootPath(sysFirmwarePath))
+
+	if boot.ID == "" && len(boot.KernelCmdline) == 0 && boot.Firmware == "" {
+		return nil, types.ErrNotImplemented
+	}
+	return &boot, nil
+}
+
+// readFirmwareType returns "uefi" or "bios" depending on the firmware that
+// booted the kernel. The efi directory is only created when the kernel was
+// booted by UEFI. Container runtimes mask /sys/firmware with an empty tmpfs,
+// so "bios" is only reported when other firmware tables (acpi or dmi) are
+// visible. Otherwise an empty string is returned.
+func readFirmwareType(dir string) string {
+	exists := func(name string) bool {
+		_, err := os.Stat(filepath.Join(dir, name))
+		return err == nil
+	}
+
+	switch {
+	case exists("efi"):
+		return "uefi"
+	case exists("acpi"), exists("dmi"):
+		return "bios"
+	default:
+		return ""
+	}
+}
+
+// bootIDCache holds the boot ID of a procFS once it has been read.
+type bootIDCache struct {
+	once sync.Once
+	id   string
+	err  error
+}
+
+// readBootID returns the random UUID generated by the kernel at boot. It
+// returns an empty string if the boot ID is not available. The boot ID does
+// not change while the system is running, so it is read once per procFS.
+func (fs *procFS) readBootID() (string, error) {
+	if fs.bootID == nil {
+		return readBootIDFile(fs.path("sys/kernel/random/boot_id"))
+	}
+	fs.bootID.once.Do(func() {
+		fs.bootID.id, fs.bootID.err = readBootIDFile(fs.path("sys/kernel/random/boot_id"))
+	})
+	return fs.bootID.id, fs.bootID.err
+}
+
+func readBootIDFile(path string) (string, error) {
+	id, err := os.ReadFile(path)
+	if err != nil && !os.IsNotExist(err) {
+		return "", fmt.Errorf("failed to read boot id: %w", err)
+	}
+	return strings.TrimSpace(string(id)), nil
+}
+
+// parseKernelCmdline parses the kernel command line into its parameters.
+// Like the kernel, double quotes may be used to include spaces in a value
+// and are removed. Arguments after "--" are passed to init and are not
+// included.
+func parseKernelCmdline(cmdline string) types.KernelCmdline {
+	var (
+		params  types.KernelCmdline
+		arg     strings.Builder
+		inQuote bool
+	)
+
+	args := make([]string, 0, strings.Count(cmdline, " ")+1)
+	for _, c := range strings.TrimSpace(cmdline) {
+		switch {
+		case c == '"':
+			inQuote = !inQuote
+		case !inQuote && (c == ' ' || c == '\t' || c == '\n'):
+			if arg.Len() > 0 {
+				args = append(args, arg.String())
+				arg.Reset()
+			}
+		default:
+			arg.WriteRune(c)
+		}
+	}
+	if arg.Len() > 0 {
+		args = append(args, arg.String())
+	}
+
+	for _, a := range args {
+		if a == "--" {
+			break
+		}
+		key, value, _ := strings.Cut(a, "=")
+		params = append(params, types.KernelParameter{Key: key, Value: value})
+	}
+
+	return params
+}
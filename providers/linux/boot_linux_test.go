@@ -0,0 +1,97 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package linux
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/go-sysinfo/types"
+)
+
+func TestHostBootInfo(t *testing.T) {
+	host, err := newLinuxSystem("testdata/fedora30").Host()
+	if err != nil {
+		t.Logf("could not get all host info: %v", err)
+	}
+
+	boot := host.Info().Boot
+	require.NotNil(t, boot)
+
+	assert.Equal(t, "6f3a2b1c-8d4e-4f5a-9b6c-7d8e9f0a1b2c", boot.ID)
+	assert.Equal(t, "(hd0,gpt2)/vmlinuz-5.0.9-301.fc30.x86_64", boot.KernelImage)
+	assert.Equal(t, "uefi", boot.Firmware)
+	assert.Equal(t, []string{"fedora/root", "fedora/swap"}, boot.KernelCmdline.Values("rd.lvm.lv"))
+	assert.Equal(t, []string{"tty0", "ttyS0,115200n8"}, boot.KernelCmdline.Values("console"))
+
+	v, found := boot.KernelCmdline.Lookup("dyndbg")
+	assert.True(t, found)
+	assert.Equal(t, "file drivers/usb/* +p", v)
+
+	v, found = boot.KernelCmdline.Lookup("quiet")
+	assert.True(t, found)
+	assert.Empty(t, v)
+}
+
+func TestHostBootInfoNotAvailable(t *testing.T) {
+	host, err := newLinuxSystem("testdata/ubuntu1710").Host()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Nil(t, host.Info().Boot)
+}
+
+func TestReadFirmwareType(t *testing.T) {
+	dir := t.TempDir()
+
+	// An empty directory is what a container runtime mounts over /sys/firmware.
+	assert.Empty(t, readFirmwareType(dir))
+	assert.Empty(t, readFirmwareType(filepath.Join(dir, "missing")))
+
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "acpi"), 0o755))
+	assert.Equal(t, "bios", readFirmwareType(dir))
+
+	require.NoError(t, os.Mkdir(filepath.Join(dir, "efi"), 0o755))
+	assert.Equal(t, "uefi", readFirmwareType(dir))
+}
+
+func TestParseKernelCmdline(t *testing.T) {
+	cmdline := parseKernelCmdline(`root=UUID=1234 ro "quoted flag" foo="a b"  console=tty0 console=ttyS0 -- init-arg x=y` + "\n")
+
+	assert.Equal(t, types.KernelCmdline{
+		{Key: "root", Value: "UUID=1234"},
+		{Key: "ro"},
+		{Key: "quoted flag"},
+		{Key: "foo", Value: "a b"},
+		{Key: "console", Value: "tty0"},
+		{Key: "console", Value: "ttyS0"},
+	}, cmdline)
+
+	v, _ := cmdline.Lookup("console")
+	assert.Equal(t, "ttyS0", v)
+
+	_, found := cmdline.Lookup("missing")
+	assert.False(t, found)
+
+	assert.Empty(t, parseKernelCmdline(""))
+}
@@ -0,0 +1,71 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package linux
+
+import (
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+)
+
+// readChildPIDs returns the sorted PIDs listed in the children files of all
+// threads in the given task directory. It returns an error satisfying
+// errors.Is(err, os.ErrNotExist) if the kernel does not provide the files.
+func readChildPIDs(taskPath string) ([]int, error) {
+	tasks, err := os.ReadDir(taskPath)
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		pids      []int
+		seen      = map[int]struct{}{}
+		available bool
+	)
+	for _, task := range tasks {
+		content, err := os.ReadFile(filepath.Join(taskPath, task.Name(), "children"))
+		if err != nil {
+			if isExited(err) {
+				// Either the thread exited or the kernel lacks the file.
+				continue
+			}
+			return nil, err
+		}
+		available = true
+
+		for _, field := range bytes.Fields(content) {
+			pid, err := strconv.Atoi(string(field))
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse child PID %q: %w", field, err)
+			}
+			if _, found := seen[pid]; !found {
+				seen[pid] = struct{}{}
+				pids = append(pids, pid)
+			}
+		}
+	}
+	if !available {
+		return nil, fmt.Errorf("no children file in %s: %w", taskPath, os.ErrNotExist)
+	}
+
+	sort.Ints(pids)
+	return pids, nil
+}
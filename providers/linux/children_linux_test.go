@@ -0,0 +1,87 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package linux
+
+import (
+	"os"
+	"os/exec"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/go-sysinfo/types"
+)
+
+func childPIDs(t *testing.T, proc types.Process) []int {
+	t.Helper()
+
+	children, err := proc.(types.Children).Children()
+	require.NoError(t, err)
+
+	pids := make([]int, 0, len(children))
+	for _, child := range children {
+		pids = append(pids, child.PID())
+	}
+	return pids
+}
+
+func TestProcessChildren(t *testing.T) {
+	system := newLinuxSystem("testdata/fedora40")
+
+	// Read from the children files of both threads.
+	proc, err := system.Process(33925)
+	require.NoError(t, err)
+	assert.Equal(t, []int{33931, 33932}, childPIDs(t, proc))
+
+	// Falls back to a scan because there is no task directory.
+	proc, err = system.Process(1)
+	require.NoError(t, err)
+	assert.Equal(t, []int{33925}, childPIDs(t, proc))
+}
+
+func TestProcessChildrenSelf(t *testing.T) {
+	cmd := exec.Command("sleep", "10")
+	if err := cmd.Start(); err != nil {
+		t.Skip("cannot start child process:", err)
+	}
+	defer func() {
+		_ = cmd.Process.Kill()
+		_ = cmd.Wait()
+	}()
+
+	proc, err := newLinuxSystem("").Self()
+	require.NoError(t, err)
+	assert.Contains(t, childPIDs(t, proc), cmd.Process.Pid)
+}
+
+func TestProcessChildrenExited(t *testing.T) {
+	cmd := exec.Command("sleep", "10")
+	if err := cmd.Start(); err != nil {
+		t.Skip("cannot start child process:", err)
+	}
+
+	proc, err := newLinuxSystem("").Process(cmd.Process.Pid)
+	require.NoError(t, err)
+
+	_ = cmd.Process.Kill()
+	_ = cmd.Wait()
+
+	_, err = proc.(types.Children).Children()
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
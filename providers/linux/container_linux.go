@@ -0,0 +1,158 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package linux
+
+import (
+	"bytes"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/elastic/go-sysinfo/types"
+)
+
+// containerScopes match the cgroup path components that contain the ID of a
+// container. The systemd cgroup driver creates a scope unit per container,
+// while the cgroupfs driver uses the plain ID. The conmon monitor processes
+// of CRI-O and Podman run in separate scopes that do not match.
+var containerScopes = []struct {
+	runtime string
+	re      *regexp.Regexp
+}{
+	{types.ContainerRuntimeDocker, regexp.MustCompile(`^docker-([0-9a-f]{64})\.scope$`)},
+	{types.ContainerRuntimeContainerd, regexp.MustCompile(`^cri-containerd-([0-9a-f]{64})\.scope$`)},
+	{types.ContainerRuntimeCRIO, regexp.MustCompile(`^crio-([0-9a-f]{64})(?:\.scope)?$`)},
+	{types.ContainerRuntimePodman, regexp.MustCompile(`^libpod-([0-9a-f]{64})(?:\.scope)?$`)},
+	{types.ContainerRuntimeNspawn, regexp.MustCompile(`^systemd-nspawn@(.+)\.service$`)},
+	{types.ContainerRuntimeNspawn, regexp.MustCompile(`^machine-(.+)\.scope$`)},
+	{"", regexp.MustCompile(`^([0-9a-f]{64})$`)},
+}
+
+// cgroupfsRuntimes maps parent cgroups of the cgroupfs driver to runtimes.
+var cgroupfsRuntimes = map[string]string{
+	"docker":        types.ContainerRuntimeDocker,
+	"crio":          types.ContainerRuntimeCRIO,
+	"libpod_parent": types.ContainerRuntimePodman,
+}
+
+var podCgroupRegexp = regexp.MustCompile(`pod([0-9a-f]{8}[-_][0-9a-f]{4}[-_][0-9a-f]{4}[-_][0-9a-f]{4}[-_][0-9a-f]{12})$`)
+
+// parseCgroupContainer returns the container of a process based on the
+// contents of /proc/[pid]/cgroup. Each line has the format
+// hierarchy-ID:controller-list:cgroup-path, where the cgroup v2 hierarchy
+// is reported as "0::<path>". It returns nil if no path refers to a
+// container or pod.
+func parseCgroupContainer(data []byte) *types.ContainerInfo {
+	var (
+		podOnly *types.ContainerInfo
+		line    []byte
+	)
+	for len(data) > 0 {
+		line, data, _ = bytes.Cut(data, []byte{'\n'})
+		parts := bytes.SplitN(line, []byte{':'}, 3)
+		if len(parts) != 3 {
+			continue
+		}
+
+		info := parseCgroupPath(string(parts[2]))
+		switch {
+		case info == nil:
+		case info.ID != "":
+			return info
+		case podOnly == nil:
+			podOnly = info
+		}
+	}
+	return podOnly
+}
+
+func parseCgroupPath(path string) *types.ContainerInfo {
+	info := &types.ContainerInfo{Cgroup: path}
+	segments := strings.Split(strings.Trim(path, "/"), "/")
+
+	// The innermost container wins for nested containers.
+	for i := len(segments) - 1; i >= 0 && info.ID == ""; i-- {
+		for _, scope := range containerScopes {
+			match := scope.re.FindStringSubmatch(segments[i])
+			if match == nil {
+				continue
+			}
+			id := unescapeSystemdUnit(match[1])
+			if scope.runtime == types.ContainerRuntimeNspawn && strings.HasPrefix(id, "qemu-") {
+				// Virtual machines registered by libvirt.
+				continue
+			}
+			info.Runtime = scope.runtime
+			info.ID = id
+			if info.Runtime == "" && i > 0 {
+				info.Runtime = cgroupfsRuntimes[segments[i-1]]
+			}
+			break
+		}
+	}
+
+	// Pods are placed below the kubepods cgroup, in a child cgroup named
+	// after the QoS class unless the pod is guaranteed.
+	var kubepods bool
+	for _, segment := range segments {
+		name := strings.TrimSuffix(segment, ".slice")
+		if strings.HasPrefix(name, "kubepods") && !kubepods {
+			kubepods = true
+			info.QoSClass = types.PodQoSGuaranteed
+		}
+		if !kubepods {
+			continue
+		}
+		for _, qos := range []string{types.PodQoSBurstable, types.PodQoSBestEffort} {
+			if name == qos || strings.HasPrefix(name, "kubepods-"+qos) {
+				info.QoSClass = qos
+			}
+		}
+		if match := podCgroupRegexp.FindStringSubmatch(name); match != nil {
+			info.PodUID = strings.ReplaceAll(match[1], "_", "-")
+		}
+	}
+	if info.PodUID == "" {
+		info.QoSClass = ""
+	}
+
+	if info.ID == "" && info.PodUID == "" {
+		return nil
+	}
+	return info
+}
+
+// unescapeSystemdUnit reverses the \xNN escaping of systemd unit names.
+func unescapeSystemdUnit(s string) string {
+	if !strings.Contains(s, `\x`) {
+		return s
+	}
+
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\\' && i+3 < len(s) && s[i+1] == 'x' {
+			if c, err := strconv.ParseUint(s[i+2:i+4], 16, 8); err == nil {
+				b.WriteByte(byte(c))
+				i += 3
+				continue
+			}
+		}
+		b.WriteByte(s[i])
+	}
+	return b.String()
+}
@@ -0,0 +1,170 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package linux
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/go-sysinfo/types"
+)
+
+const kubernetesSystemdCgroupV2 = `0::/kubepods.slice/kubepods-besteffort.slice/kubepods-besteffort-pod0f6e4a52_9c1b_4d0e_b4a3_7f2c8e9d1a36.slice/cri-containerd-5b2e1f0c9d8a7b6c5d4e3f2a1b0c9d8e7f6a5b4c3d2e1f0a9b8c7d6e5f4a3b2c.scope
+`
+
+const guaranteedPodCRIOCgroupV2 = `0::/kubepods.slice/kubepods-pod6d1c4e2b_3a5f_4b7c_9d8e_1f2a3b4c5d6e.slice/crio-c0ffee0123456789abcdef0123456789abcdef0123456789abcdef0123456789.scope
+`
+
+const crioConmonCgroupV2 = `0::/kubepods.slice/kubepods-pod6d1c4e2b_3a5f_4b7c_9d8e_1f2a3b4c5d6e.slice/crio-conmon-c0ffee0123456789abcdef0123456789abcdef0123456789abcdef0123456789.scope
+`
+
+const dockerSystemdCgroupV2 = `0::/system.slice/docker-81438f4655cd771c425607dcf7654f4dc03c073c0123edc45fcfad28132e8c60.scope
+`
+
+const rootlessPodmanCgroupV2 = `0::/user.slice/user-1000.slice/user@1000.service/user.slice/libpod-2a9b8c7d6e5f4a3b2c1d0e9f8a7b6c5d4e3f2a1b0c9d8e7f6a5b4c3d2e1f0a9b.scope/container
+`
+
+const nspawnCgroupV2 = `0::/machine.slice/systemd-nspawn@debian\x2dbuild.service/payload
+`
+
+const libvirtCgroupV2 = `0::/machine.slice/machine-qemu\x2d1\x2dwin11.scope/libvirt/emulator
+`
+
+const dockerServiceCgroupV2 = `0::/system.slice/docker.service
+`
+
+func TestParseCgroupContainer(t *testing.T) {
+	tests := []struct {
+		name   string
+		cgroup string
+		want   *types.ContainerInfo
+	}{
+		{
+			name:   "docker cgroup v1",
+			cgroup: containerCgroup,
+			want: &types.ContainerInfo{
+				Runtime: types.ContainerRuntimeDocker,
+				ID:      "81438f4655cd771c425607dcf7654f4dc03c073c0123edc45fcfad28132e8c60",
+				Cgroup:  "/docker/81438f4655cd771c425607dcf7654f4dc03c073c0123edc45fcfad28132e8c60",
+			},
+		},
+		{
+			name:   "kubernetes cgroupfs v1",
+			cgroup: kubernetesCgroup,
+			want: &types.ContainerInfo{
+				ID:       "9f99515d52142271cfeebef269bf4b7609b9b69b62008d6a5d316f561ccf061d",
+				PodUID:   "b83789a8-5f9d-11ea-bae1-0a0084deb344",
+				QoSClass: types.PodQoSBurstable,
+				Cgroup:   "/kubepods/burstable/podb83789a8-5f9d-11ea-bae1-0a0084deb344/9f99515d52142271cfeebef269bf4b7609b9b69b62008d6a5d316f561ccf061d",
+			},
+		},
+		{
+			name:   "kubernetes containerd cgroup v2",
+			cgroup: kubernetesSystemdCgroupV2,
+			want: &types.ContainerInfo{
+				Runtime:  types.ContainerRuntimeContainerd,
+				ID:       "5b2e1f0c9d8a7b6c5d4e3f2a1b0c9d8e7f6a5b4c3d2e1f0a9b8c7d6e5f4a3b2c",
+				PodUID:   "0f6e4a52-9c1b-4d0e-b4a3-7f2c8e9d1a36",
+				QoSClass: types.PodQoSBestEffort,
+				Cgroup:   "/kubepods.slice/kubepods-besteffort.slice/kubepods-besteffort-pod0f6e4a52_9c1b_4d0e_b4a3_7f2c8e9d1a36.slice/cri-containerd-5b2e1f0c9d8a7b6c5d4e3f2a1b0c9d8e7f6a5b4c3d2e1f0a9b8c7d6e5f4a3b2c.scope",
+			},
+		},
+		{
+			name:   "kubernetes guaranteed cri-o cgroup v2",
+			cgroup: guaranteedPodCRIOCgroupV2,
+			want: &types.ContainerInfo{
+				Runtime:  types.ContainerRuntimeCRIO,
+				ID:       "c0ffee0123456789abcdef0123456789abcdef0123456789abcdef0123456789",
+				PodUID:   "6d1c4e2b-3a5f-4b7c-9d8e-1f2a3b4c5d6e",
+				QoSClass: types.PodQoSGuaranteed,
+				Cgroup:   "/kubepods.slice/kubepods-pod6d1c4e2b_3a5f_4b7c_9d8e_1f2a3b4c5d6e.slice/crio-c0ffee0123456789abcdef0123456789abcdef0123456789abcdef0123456789.scope",
+			},
+		},
+		{
+			name:   "cri-o conmon cgroup v2",
+			cgroup: crioConmonCgroupV2,
+			want: &types.ContainerInfo{
+				PodUID:   "6d1c4e2b-3a5f-4b7c-9d8e-1f2a3b4c5d6e",
+				QoSClass: types.PodQoSGuaranteed,
+				Cgroup:   "/kubepods.slice/kubepods-pod6d1c4e2b_3a5f_4b7c_9d8e_1f2a3b4c5d6e.slice/crio-conmon-c0ffee0123456789abcdef0123456789abcdef0123456789abcdef0123456789.scope",
+			},
+		},
+		{
+			name:   "docker cgroup v2",
+			cgroup: dockerSystemdCgroupV2,
+			want: &types.ContainerInfo{
+				Runtime: types.ContainerRuntimeDocker,
+				ID:      "81438f4655cd771c425607dcf7654f4dc03c073c0123edc45fcfad28132e8c60",
+				Cgroup:  "/system.slice/docker-81438f4655cd771c425607dcf7654f4dc03c073c0123edc45fcfad28132e8c60.scope",
+			},
+		},
+		{
+			name:   "rootless podman cgroup v2",
+			cgroup: rootlessPodmanCgroupV2,
+			want: &types.ContainerInfo{
+				Runtime: types.ContainerRuntimePodman,
+				ID:      "2a9b8c7d6e5f4a3b2c1d0e9f8a7b6c5d4e3f2a1b0c9d8e7f6a5b4c3d2e1f0a9b",
+				Cgroup:  "/user.slice/user-1000.slice/user@1000.service/user.slice/libpod-2a9b8c7d6e5f4a3b2c1d0e9f8a7b6c5d4e3f2a1b0c9d8e7f6a5b4c3d2e1f0a9b.scope/container",
+			},
+		},
+		{
+			name:   "systemd-nspawn cgroup v2",
+			cgroup: nspawnCgroupV2,
+			want: &types.ContainerInfo{
+				Runtime: types.ContainerRuntimeNspawn,
+				ID:      "debian-build",
+				Cgroup:  `/machine.slice/systemd-nspawn@debian\x2dbuild.service/payload`,
+			},
+		},
+		{name: "libvirt virtual machine", cgroup: libvirtCgroupV2},
+		{name: "docker daemon", cgroup: dockerServiceCgroupV2},
+		{name: "not containerized", cgroup: nonContainerizedCgroup},
+		{name: "host PID namespace", cgroup: containerHostPIDNamespaceCgroup},
+		{name: "empty", cgroup: emptyCgroup},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, parseCgroupContainer([]byte(tc.cgroup)))
+		})
+	}
+}
+
+func TestProcessContainer(t *testing.T) {
+	system := newLinuxSystem("testdata/fedora40")
+
+	proc, err := system.Process(33925)
+	require.NoError(t, err)
+	container, err := proc.(types.Container).Container()
+	require.NoError(t, err)
+	assert.Equal(t, &types.ContainerInfo{
+		Runtime:  types.ContainerRuntimeContainerd,
+		ID:       "7d4c3b2a19f8e7d6c5b4a39281706f5e4d3c2b1a09f8e7d6c5b4a3928170abcd",
+		PodUID:   "3e1f8b2a-5c4d-4e6f-8a9b-0c1d2e3f4a5b",
+		QoSClass: types.PodQoSBurstable,
+		Cgroup:   "/kubepods.slice/kubepods-burstable.slice/kubepods-burstable-pod3e1f8b2a_5c4d_4e6f_8a9b_0c1d2e3f4a5b.slice/cri-containerd-7d4c3b2a19f8e7d6c5b4a39281706f5e4d3c2b1a09f8e7d6c5b4a3928170abcd.scope",
+	}, container)
+
+	proc, err = system.Process(1)
+	require.NoError(t, err)
+	container, err = proc.(types.Container).Container()
+	require.NoError(t, err)
+	assert.Nil(t, container)
+}
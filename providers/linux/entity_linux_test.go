@@ -0,0 +1,93 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package linux
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/go-sysinfo/types"
+)
+
+func TestProcessEntityKey(t *testing.T) {
+	proc, err := newLinuxSystem("testdata/fedora40").Process(33925)
+	require.NoError(t, err)
+
+	key, err := proc.(types.EntityKeyer).EntityKey()
+	require.NoError(t, err)
+
+	assert.Equal(t, types.ProcessEntityKey{
+		PID:        33925,
+		StartTicks: 840035,
+		BootID:     "0d4a3f5e-8c2b-4f7a-9b61-2e7c5d1a8f34",
+	}, key)
+	assert.Equal(t, "0d4a3f5e-8c2b-4f7a-9b61-2e7c5d1a8f34/33925/840035", key.String())
+}
+
+func TestProcessLineage(t *testing.T) {
+	system := newLinuxSystem("testdata/fedora40")
+
+	proc, err := system.Process(33931)
+	require.NoError(t, err)
+	lineage, err := proc.(types.Lineage).Lineage()
+	require.NoError(t, err)
+	assert.Equal(t, 33925, lineage.PPID)
+
+	key, err := proc.(types.EntityKeyer).EntityKey()
+	require.NoError(t, err)
+	assert.Equal(t, key, lineage.Key)
+
+	// The boot ID is read once and shared by all processes of the system.
+	assert.Equal(t, "0d4a3f5e-8c2b-4f7a-9b61-2e7c5d1a8f34", system.procFS.bootID.id)
+}
+
+func TestProcessParentStartTime(t *testing.T) {
+	system := newLinuxSystem("testdata/fedora40")
+
+	proc, err := system.Process(33931)
+	require.NoError(t, err)
+	parent, err := proc.Parent()
+	require.NoError(t, err)
+	assert.Equal(t, 33925, parent.PID())
+
+	// The parent PID 33932 belongs to a process that started after 33940.
+	proc, err = system.Process(33940)
+	require.NoError(t, err)
+	_, err = proc.Parent()
+	assert.ErrorIs(t, err, types.ErrParentPIDReused)
+}
+
+func TestProcessEntityKeySelf(t *testing.T) {
+	proc, err := newLinuxSystem("").Self()
+	require.NoError(t, err)
+
+	key, err := proc.(types.EntityKeyer).EntityKey()
+	require.NoError(t, err)
+	assert.Equal(t, os.Getpid(), key.PID)
+	assert.NotZero(t, key.StartTicks)
+
+	parent, err := proc.Parent()
+	require.NoError(t, err)
+	parentKey, err := parent.(types.EntityKeyer).EntityKey()
+	require.NoError(t, err)
+	assert.LessOrEqual(t, parentKey.StartTicks, key.StartTicks)
+	assert.Equal(t, key.BootID, parentKey.BootID)
+}
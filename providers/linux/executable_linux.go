@@ -0,0 +1,218 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package linux
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"debug/elf"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"syscall"
+
+	"github.com/elastic/go-sysinfo/types"
+)
+
+// executableHashSizeLimit is the maximum size of an executable that is
+// hashed. Larger executables are reported without SHA-256.
+const executableHashSizeLimit = 512 << 20
+
+// executableHashCacheSize is the maximum number of cached executable hashes.
+const executableHashCacheSize = 1024
+
+const deletedSuffix = " (deleted)"
+
+// readExecutable reads the information about the executable of a process.
+// The file is opened through /proc/[pid]/exe so the inspected file is the
+// one that the process runs, even if it was replaced on disk.
+func readExecutable(exePath string) (*types.ExecutableInfo, error) {
+	link, err := os.Readlink(exePath)
+	if err != nil {
+		return nil, fmt.Errorf("error reading exe link: %w", err)
+	}
+
+	f, err := os.Open(exePath)
+	if err != nil {
+		return nil, fmt.Errorf("error opening executable: %w", err)
+	}
+	defer f.Close()
+
+	fi, err := f.Stat()
+	if err != nil {
+		return nil, fmt.Errorf("error reading executable file info: %w", err)
+	}
+
+	exe := &types.ExecutableInfo{
+		Path:    strings.TrimSuffix(link, deletedSuffix),
+		Size:    fi.Size(),
+		ModTime: fi.ModTime(),
+		MemFD:   isMemFDPath(link),
+	}
+	var changeTime int64
+	if st, ok := fi.Sys().(*syscall.Stat_t); ok {
+		changeTime = st.Ctim.Nano()
+		exe.Device = uint64(st.Dev)
+		exe.Inode = st.Ino
+		exe.UID = strconv.FormatUint(uint64(st.Uid), 10)
+		exe.GID = strconv.FormatUint(uint64(st.Gid), 10)
+		exe.Deleted = !exe.MemFD && (st.Nlink == 0 || strings.HasSuffix(link, deletedSuffix))
+	} else {
+		exe.Deleted = !exe.MemFD && strings.HasSuffix(link, deletedSuffix)
+	}
+
+	if fi.Size() <= executableHashSizeLimit {
+		key := executableKey{
+			device:     exe.Device,
+			inode:      exe.Inode,
+			modTime:    fi.ModTime().UnixNano(),
+			changeTime: changeTime,
+			size:       fi.Size(),
+		}
+		if exe.SHA256, err = executableHash(f, key); err != nil {
+			return nil, err
+		}
+	}
+
+	exe.BuildID, err = elfBuildID(f)
+	if err != nil {
+		return nil, err
+	}
+
+	return exe, nil
+}
+
+// executableKey identifies the contents of an executable file. The
+// modification time can be set by the owner of the file, so the key includes
+// the inode change time, which the kernel updates on every write.
+type executableKey struct {
+	device, inode       uint64
+	modTime, changeTime int64
+	size                int64
+}
+
+// executableHashes caches the SHA-256 of executables so that each executable
+// is hashed once rather than on every call. The cache is cleared when it
+// reaches executableHashCacheSize entries.
+var executableHashes = struct {
+	sync.Mutex
+	hashes map[executableKey]string
+}{hashes: map[executableKey]string{}}
+
+// executableHash returns the hex encoded SHA-256 of f. The result is cached
+// by key if the file has a device and inode number.
+func executableHash(f io.Reader, key executableKey) (string, error) {
+	cacheable := key.inode != 0
+	if cacheable {
+		executableHashes.Lock()
+		sum, found := executableHashes.hashes[key]
+		executableHashes.Unlock()
+		if found {
+			return sum, nil
+		}
+	}
+
+	h := sha256.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", fmt.Errorf("error hashing executable: %w", err)
+	}
+	sum := hex.EncodeToString(h.Sum(nil))
+
+	if cacheable {
+		executableHashes.Lock()
+		if len(executableHashes.hashes) >= executableHashCacheSize {
+			clear(executableHashes.hashes)
+		}
+		executableHashes.hashes[key] = sum
+		executableHashes.Unlock()
+	}
+	return sum, nil
+}
+
+// isMemFDPath returns true if the exe link target refers to anonymous memory
+// rather than a file on disk. memfd_create(2) files are shown as
+// "/memfd:<name> (deleted)".
+func isMemFDPath(link string) bool {
+	return strings.HasPrefix(link, "/memfd:") || !strings.HasPrefix(link, "/")
+}
+
+// elfBuildID returns the hex encoded NT_GNU_BUILD_ID note of an ELF file.
+// It returns an empty string for files that are not ELF or have no build ID.
+func elfBuildID(r io.ReaderAt) (string, error) {
+	f, err := elf.NewFile(r)
+	if err != nil {
+		// Not an ELF file (e.g. a script run via binfmt_misc).
+		return "", nil
+	}
+	defer f.Close()
+
+	// Use the program headers because section headers may be stripped.
+	for _, prog := range f.Progs {
+		if prog.Type != elf.PT_NOTE {
+			continue
+		}
+		notes, err := io.ReadAll(prog.Open())
+		if err != nil {
+			return "", fmt.Errorf("error reading ELF notes: %w", err)
+		}
+		if id := findGNUBuildID(notes, f.ByteOrder, prog.Align); id != nil {
+			return hex.EncodeToString(id), nil
+		}
+	}
+	return "", nil
+}
+
+// findGNUBuildID returns the descriptor of the NT_GNU_BUILD_ID note in the
+// contents of a PT_NOTE segment. Each note consists of a header with the
+// name size, descriptor size and type followed by the padded name and
+// descriptor.
+func findGNUBuildID(notes []byte, order binary.ByteOrder, align uint64) []byte {
+	const ntGNUBuildID = 3
+
+	if align < 4 {
+		align = 4
+	}
+	pad := func(n uint64) uint64 { return (n + align - 1) &^ (align - 1) }
+
+	for uint64(len(notes)) >= 12 {
+		nameSize := uint64(order.Uint32(notes[0:4]))
+		descSize := uint64(order.Uint32(notes[4:8]))
+		noteType := order.Uint32(notes[8:12])
+		notes = notes[12:]
+
+		nameEnd := pad(nameSize)
+		descEnd := nameEnd + pad(descSize)
+		if nameEnd > uint64(len(notes)) || nameEnd+descSize > uint64(len(notes)) {
+			return nil
+		}
+		name := notes[:nameSize]
+		if noteType == ntGNUBuildID && bytes.Equal(name, []byte("GNU\x00")) {
+			return notes[nameEnd : nameEnd+descSize]
+		}
+		if descEnd >= uint64(len(notes)) {
+			return nil
+		}
+		notes = notes[descEnd:]
+	}
+	return nil
+}
@@ -0,0 +1,242 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package linux
+
+import (
+	"crypto/sha256"
+	"debug/elf"
+	"encoding/binary"
+	"encoding/hex"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sys/unix"
+
+	"github.com/elastic/go-sysinfo/types"
+)
+
+// startExecutable runs the executable at path and returns the information
+// about the executable of the started process. exec.Cmd.Start returns after
+// the new program has been executed.
+func startExecutable(t *testing.T, path string) *types.ExecutableInfo {
+	t.Helper()
+
+	cmd := exec.Command(path, "10")
+	if err := cmd.Start(); err != nil {
+		t.Skip("cannot start process:", err)
+	}
+	t.Cleanup(func() {
+		_ = cmd.Process.Kill()
+		_ = cmd.Wait()
+	})
+
+	proc, err := newLinuxSystem("").Process(cmd.Process.Pid)
+	require.NoError(t, err)
+
+	exe, err := proc.(types.Executable).Executable()
+	require.NoError(t, err)
+	return exe
+}
+
+// copySleep copies the sleep binary to dst and returns its contents.
+func copySleep(t *testing.T, dst string) []byte {
+	t.Helper()
+
+	src, err := exec.LookPath("sleep")
+	if err != nil {
+		t.Skip("sleep not found:", err)
+	}
+	content, err := os.ReadFile(src)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(dst, content, 0o755))
+	return content
+}
+
+func TestProcessExecutable(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "sleep")
+	content := copySleep(t, path)
+	sum := sha256.Sum256(content)
+
+	exe := startExecutable(t, path)
+	assert.Equal(t, path, exe.Path)
+	assert.EqualValues(t, len(content), exe.Size)
+	assert.NotZero(t, exe.Inode)
+	assert.Equal(t, strconv.Itoa(os.Getuid()), exe.UID)
+	assert.Equal(t, hex.EncodeToString(sum[:]), exe.SHA256)
+	assert.False(t, exe.Deleted)
+	assert.False(t, exe.MemFD)
+
+	// The build ID is read from the program headers. It must match the
+	// build ID in the .note.gnu.build-id section (see readelf -n).
+	assert.Equal(t, sectionBuildID(t, path), exe.BuildID)
+}
+
+// sectionBuildID returns the hex encoded descriptor of the
+// .note.gnu.build-id section of the ELF file at path.
+func sectionBuildID(t *testing.T, path string) string {
+	t.Helper()
+
+	f, err := elf.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+
+	section := f.Section(".note.gnu.build-id")
+	if section == nil {
+		t.Skip("executable has no .note.gnu.build-id section")
+	}
+	note, err := section.Data()
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, len(note), 16)
+
+	// The note header is followed by the name "GNU\x00" and the descriptor.
+	nameSize := f.ByteOrder.Uint32(note[0:4])
+	descSize := f.ByteOrder.Uint32(note[4:8])
+	require.EqualValues(t, 4, nameSize)
+	require.Equal(t, "GNU\x00", string(note[12:16]))
+	require.GreaterOrEqual(t, len(note), 16+int(descSize))
+	return hex.EncodeToString(note[16 : 16+descSize])
+}
+
+func TestExecutableHashCache(t *testing.T) {
+	key := executableKey{device: 1, inode: 2, modTime: 3, size: 5}
+	sum := sha256.Sum256([]byte("hello"))
+
+	hash, err := executableHash(strings.NewReader("hello"), key)
+	require.NoError(t, err)
+	assert.Equal(t, hex.EncodeToString(sum[:]), hash)
+
+	// A file with the same identity is not read again.
+	hash, err = executableHash(strings.NewReader("world"), key)
+	require.NoError(t, err)
+	assert.Equal(t, hex.EncodeToString(sum[:]), hash)
+
+	// A modified file is hashed again.
+	key.modTime++
+	hash, err = executableHash(strings.NewReader("world"), key)
+	require.NoError(t, err)
+	assert.NotEqual(t, hex.EncodeToString(sum[:]), hash)
+}
+
+func TestReadExecutableRewritten(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "app")
+	link := filepath.Join(dir, "exe")
+	require.NoError(t, os.WriteFile(path, []byte("original"), 0o755))
+	require.NoError(t, os.Symlink(path, link))
+
+	fi, err := os.Stat(path)
+	require.NoError(t, err)
+	exe, err := readExecutable(link)
+	require.NoError(t, err)
+	sum := sha256.Sum256([]byte("original"))
+	assert.Equal(t, hex.EncodeToString(sum[:]), exe.SHA256)
+
+	// Overwrite the file in place with the same size and restore its
+	// modification time.
+	f, err := os.OpenFile(path, os.O_WRONLY, 0)
+	require.NoError(t, err)
+	_, err = f.WriteAt([]byte("tampered"), 0)
+	require.NoError(t, err)
+	require.NoError(t, f.Close())
+	require.NoError(t, os.Chtimes(path, fi.ModTime(), fi.ModTime()))
+
+	exe, err = readExecutable(link)
+	require.NoError(t, err)
+	assert.Equal(t, fi.ModTime(), exe.ModTime)
+	sum = sha256.Sum256([]byte("tampered"))
+	assert.Equal(t, hex.EncodeToString(sum[:]), exe.SHA256)
+}
+
+func TestProcessExecutableDeleted(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "sleep")
+	content := copySleep(t, path)
+	sum := sha256.Sum256(content)
+
+	cmd := exec.Command(path, "10")
+	require.NoError(t, cmd.Start())
+	t.Cleanup(func() {
+		_ = cmd.Process.Kill()
+		_ = cmd.Wait()
+	})
+	require.NoError(t, os.Remove(path))
+
+	proc, err := newLinuxSystem("").Process(cmd.Process.Pid)
+	require.NoError(t, err)
+	exe, err := proc.(types.Executable).Executable()
+	require.NoError(t, err)
+
+	assert.Equal(t, path, exe.Path)
+	assert.True(t, exe.Deleted)
+	assert.False(t, exe.MemFD)
+	assert.Equal(t, hex.EncodeToString(sum[:]), exe.SHA256)
+}
+
+func TestProcessExecutableMemFD(t *testing.T) {
+	fd, err := unix.MemfdCreate("payload", 0)
+	if err != nil {
+		t.Skip("memfd_create not supported:", err)
+	}
+	memfd := os.NewFile(uintptr(fd), "payload")
+	defer memfd.Close()
+
+	src, err := exec.LookPath("sleep")
+	if err != nil {
+		t.Skip("sleep not found:", err)
+	}
+	content, err := os.ReadFile(src)
+	require.NoError(t, err)
+	_, err = memfd.Write(content)
+	require.NoError(t, err)
+
+	exe := startExecutable(t, "/proc/"+strconv.Itoa(os.Getpid())+"/fd/"+strconv.Itoa(fd))
+	assert.Equal(t, "/memfd:payload", exe.Path)
+	assert.True(t, exe.MemFD)
+	assert.False(t, exe.Deleted)
+}
+
+func TestFindGNUBuildID(t *testing.T) {
+	note := func(name string, typ uint32, desc []byte) []byte {
+		var b []byte
+		b = binary.LittleEndian.AppendUint32(b, uint32(len(name)))
+		b = binary.LittleEndian.AppendUint32(b, uint32(len(desc)))
+		b = binary.LittleEndian.AppendUint32(b, typ)
+		b = append(b, name...)
+		for len(b)%4 != 0 {
+			b = append(b, 0)
+		}
+		b = append(b, desc...)
+		for len(b)%4 != 0 {
+			b = append(b, 0)
+		}
+		return b
+	}
+
+	id := []byte{0xde, 0xad, 0xbe, 0xef, 0x01}
+	notes := append(note("GNU\x00", 5, []byte{1, 2, 3, 4}), note("Go\x00\x00", 4, []byte("go-build-id"))...)
+	notes = append(notes, note("GNU\x00", 3, id)...)
+
+	assert.Equal(t, id, findGNUBuildID(notes, binary.LittleEndian, 4))
+	assert.Nil(t, findGNUBuildID(notes[:len(notes)-4], binary.LittleEndian, 4))
+	assert.Nil(t, findGNUBuildID(note("GNU\x00", 5, []byte{1, 2, 3, 4}), binary.LittleEndian, 4))
+}
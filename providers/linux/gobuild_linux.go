@@ -0,0 +1,115 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package linux
+
+import (
+	"debug/buildinfo"
+	"debug/elf"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"runtime/debug"
+
+	"github.com/elastic/go-sysinfo/types"
+)
+
+// readGoBuildInfo reads the build information of the Go executable at path.
+func readGoBuildInfo(path string) (*types.GoBuildInfo, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, fmt.Errorf("error opening executable: %w", err)
+	}
+	defer f.Close()
+
+	if err := checkGoExecutable(f); err != nil {
+		return nil, err
+	}
+
+	info, err := buildinfo.Read(f)
+	if err != nil {
+		return nil, fmt.Errorf("error reading build info: %w", err)
+	}
+
+	return convertGoBuildInfo(info), nil
+}
+
+// checkGoExecutable returns an error wrapping types.ErrNotGoExecutable when
+// f is not an ELF file or when its section headers do not contain the
+// .go.buildinfo section that the Go linker writes. Files without section
+// headers are left to buildinfo.Read, which then scans the writable data
+// segment instead.
+func checkGoExecutable(f io.ReaderAt) error {
+	ef, err := elf.NewFile(f)
+	if err != nil {
+		var formatErr *elf.FormatError
+		if errors.As(err, &formatErr) || errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+			return fmt.Errorf("%w: not an ELF file", types.ErrNotGoExecutable)
+		}
+		return fmt.Errorf("error reading ELF header: %w", err)
+	}
+
+	if len(ef.Sections) > 0 && ef.Section(".go.buildinfo") == nil {
+		return fmt.Errorf("%w: no .go.buildinfo section", types.ErrNotGoExecutable)
+	}
+	return nil
+}
+
+func convertGoBuildInfo(info *debug.BuildInfo) *types.GoBuildInfo {
+	b := &types.GoBuildInfo{
+		GoVersion: info.GoVersion,
+		Path:      info.Path,
+		Main:      convertGoModule(&info.Main),
+	}
+
+	for _, dep := range info.Deps {
+		b.Deps = append(b.Deps, convertGoModule(dep))
+	}
+
+	for _, s := range info.Settings {
+		b.Settings = append(b.Settings, types.GoBuildSetting{Key: s.Key, Value: s.Value})
+
+		switch s.Key {
+		case "CGO_ENABLED":
+			b.CGOEnabled = s.Value == "1"
+		case "-trimpath":
+			b.TrimPath = s.Value == "true"
+		case "vcs.revision":
+			b.VCSRevision = s.Value
+		case "vcs.time":
+			b.VCSTime = s.Value
+		case "vcs.modified":
+			b.VCSModified = s.Value == "true"
+		}
+	}
+
+	return b
+}
+
+func convertGoModule(m *debug.Module) types.GoModule {
+	mod := types.GoModule{
+		Path:    m.Path,
+		Version: m.Version,
+		Sum:     m.Sum,
+	}
+	if m.Replace != nil {
+		replace := convertGoModule(m.Replace)
+		mod.Replace = &replace
+	}
+	return mod
+}
@@ -0,0 +1,138 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package linux
+
+import (
+	"debug/buildinfo"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"runtime/debug"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/go-sysinfo/types"
+)
+
+func TestProcessGoBuildSelf(t *testing.T) {
+	proc, err := newLinuxSystem("").Self()
+	require.NoError(t, err)
+
+	info, err := proc.(types.GoBuild).GoBuild()
+	require.NoError(t, err)
+
+	self, ok := debug.ReadBuildInfo()
+	require.True(t, ok)
+	assert.Equal(t, runtime.Version(), info.GoVersion)
+	assert.Equal(t, self.Path, info.Path)
+	assert.Equal(t, len(self.Deps), len(info.Deps))
+	assert.Equal(t, len(self.Settings), len(info.Settings))
+}
+
+func TestProcessGoBuildNotGo(t *testing.T) {
+	cmd := exec.Command("sleep", "10")
+	if err := cmd.Start(); err != nil {
+		t.Skip("cannot start process:", err)
+	}
+	defer func() {
+		_ = cmd.Process.Kill()
+		_ = cmd.Wait()
+	}()
+
+	proc, err := newLinuxSystem("").Process(cmd.Process.Pid)
+	require.NoError(t, err)
+
+	_, err = proc.(types.GoBuild).GoBuild()
+	assert.ErrorIs(t, err, types.ErrNotGoExecutable)
+}
+
+func TestCheckGoExecutable(t *testing.T) {
+	self, err := os.Executable()
+	require.NoError(t, err)
+	checkFile(t, self, func(f *os.File) {
+		assert.NoError(t, checkGoExecutable(f))
+	})
+
+	dir := t.TempDir()
+	script := filepath.Join(dir, "script.sh")
+	require.NoError(t, os.WriteFile(script, []byte("#!/bin/sh\nexit 0\n"), 0o755))
+	empty := filepath.Join(dir, "empty")
+	require.NoError(t, os.WriteFile(empty, nil, 0o755))
+	notGo := []string{script, empty}
+	if sleep, err := exec.LookPath("sleep"); err == nil {
+		notGo = append(notGo, sleep)
+	}
+
+	for _, path := range notGo {
+		checkFile(t, path, func(f *os.File) {
+			err := checkGoExecutable(f)
+			assert.ErrorIs(t, err, types.ErrNotGoExecutable, path)
+			assert.NotContains(t, err.Error(), "not a Go executable: not a Go executable", path)
+
+			// buildinfo.Read must agree with the classification.
+			_, err = buildinfo.Read(f)
+			assert.Error(t, err, path)
+		})
+	}
+}
+
+func checkFile(t *testing.T, path string, fn func(*os.File)) {
+	t.Helper()
+	f, err := os.Open(path)
+	require.NoError(t, err)
+	defer f.Close()
+	fn(f)
+}
+
+func TestConvertGoBuildInfo(t *testing.T) {
+	info := convertGoBuildInfo(&debug.BuildInfo{
+		GoVersion: "go1.22.3",
+		Path:      "github.com/example/app/cmd/app",
+		Main:      debug.Module{Path: "github.com/example/app", Version: "(devel)"},
+		Deps: []*debug.Module{
+			{Path: "golang.org/x/sys", Version: "v0.20.0", Sum: "h1:Od9JTbYCk261bKm4M/mw7AklTlFYIa0bIp9BgSm1S8Y="},
+			{
+				Path:    "github.com/example/lib",
+				Version: "v1.0.0",
+				Replace: &debug.Module{Path: "../lib", Version: ""},
+			},
+		},
+		Settings: []debug.BuildSetting{
+			{Key: "-trimpath", Value: "true"},
+			{Key: "CGO_ENABLED", Value: "0"},
+			{Key: "GOARCH", Value: "amd64"},
+			{Key: "vcs.revision", Value: "3f1c2a9d"},
+			{Key: "vcs.time", Value: "2024-05-01T10:00:00Z"},
+			{Key: "vcs.modified", Value: "true"},
+		},
+	})
+
+	assert.Equal(t, "go1.22.3", info.GoVersion)
+	assert.Equal(t, types.GoModule{Path: "github.com/example/app", Version: "(devel)"}, info.Main)
+	require.Len(t, info.Deps, 2)
+	assert.Equal(t, &types.GoModule{Path: "../lib"}, info.Deps[1].Replace)
+	assert.Len(t, info.Settings, 6)
+	assert.False(t, info.CGOEnabled)
+	assert.True(t, info.TrimPath)
+	assert.Equal(t, "3f1c2a9d", info.VCSRevision)
+	assert.Equal(t, "2024-05-01T10:00:00Z", info.VCSTime)
+	assert.True(t, info.VCSModified)
+}
@@ -35,18 +35,23 @@ import (
 func init() {
 	// register wrappers that implement the HostFS versions of the ProcessProvider and HostProvider
 	registry.Register(func(opts registry.ProviderOptions) registry.HostProvider { return newLinuxSystem(opts.Hostfs) })
-	registry.Register(func(opts registry.ProviderOptions) registry.ProcessProvider { return newLinuxSystem(opts.Hostfs) })
+	registry.Register(func(opts registry.ProviderOptions) registry.ProcessProvider {
+		s := newLinuxSystem(opts.Hostfs)
+		s.redactor = opts.Redactor
+		return s
+	})
 }
 
 type linuxSystem struct {
-	procFS procFS
+	procFS   procFS
+	redactor *types.Redactor
 }
 
 func newLinuxSystem(hostFS string) linuxSystem {
 	mountPoint := filepath.Join(hostFS, procfs.DefaultMountPoint)
 	fs, _ := procfs.NewFS(mountPoint)
 	return linuxSystem{
-		procFS: procFS{FS: fs, mountPoint: mountPoint, baseMount: hostFS},
+		procFS: procFS{FS: fs, mountPoint: mountPoint, baseMount: hostFS, bootID: &bootIDCache{}},
 	}
 }
 
@@ -76,6 +81,17 @@ func (h *host) Memory() (*types.HostMemoryInfo, error) {
 	return parseMemInfo(content)
 }
 
+// MemInfo returns the typed contents of /proc/meminfo
+func (h *host) MemInfo() (*types.MemInfoDetail, error) {
+	path := h.procFS.path("meminfo")
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading meminfo file %s: %w", path, err)
+	}
+
+	return parseMemInfoDetail(content)
+}
+
 func (h *host) FQDNWithContext(ctx context.Context) (string, error) {
 	return shared.FQDNWithContext(ctx)
 }
@@ -84,6 +100,74 @@ func (h *host) FQDN() (string, error) {
 	return h.FQDNWithContext(context.Background())
 }
 
+// DNSConfig reports the name resolution configuration from resolv.conf,
+// nsswitch.conf and hosts files of the host filesystem.
+func (h *host) DNSConfig() (*types.DNSConfigInfo, error) {
+	return shared.DNSConfig(h.procFS.baseMount)
+}
+
+// NUMA reports the NUMA topology from /sys/devices/system/node on linux. It
+// returns types.ErrNotImplemented if the topology is not available.
+func (h *host) NUMA() ([]types.NUMANode, error) {
+	nodes, err := readNUMANodes(h.procFS.rootPath(sysNodePath))
+	if errors.Is(err, types.ErrNotImplemented) {
+		return nil, err
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error reading NUMA nodes: %w", err)
+	}
+
+	return nodes, nil
+}
+
+// HugePages reports the huge page pools from /sys/kernel/mm/hugepages and the
+// transparent huge page settings on linux.
+func (h *host) HugePages() (*types.HugePagesInfo, error) {
+	pools, err := readHugePages(h.procFS.rootPath(sysHugePagesPath), h.procFS.rootPath(sysNodePath))
+	if err != nil {
+		return nil, fmt.Errorf("error reading huge page pools: %w", err)
+	}
+
+	thp, err := readTransparentHugePages(h.procFS.rootPath(sysTransparentPath))
+	if err != nil {
+		return nil, fmt.Errorf("error reading transparent huge page settings: %w", err)
+	}
+
+	return &types.HugePagesInfo{Pools: pools, Transparent: thp}, nil
+}
+
+// Swap reports the swap areas from /proc/swaps together with the zram
+// devices and zswap settings on linux.
+func (h *host) Swap() (*types.SwapInfo, error) {
+	path := h.procFS.path("swaps")
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading swaps file %s: %w", path, err)
+	}
+	devices, err := parseSwaps(content)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing swaps file %s: %w", path, err)
+	}
+
+	zram, err := readZRAMDevices(h.procFS.rootPath(sysBlockPath))
+	if err != nil {
+		return nil, fmt.Errorf("error reading zram devices: %w", err)
+	}
+
+	zswap, err := readZswap(h.procFS.rootPath(sysZswapParamsPath), h.procFS.rootPath(debugfsZswapPath))
+	if err != nil {
+		return nil, fmt.Errorf("error reading zswap settings: %w", err)
+	}
+
+	return &types.SwapInfo{Devices: devices, ZRAM: zram, Zswap: zswap}, nil
+}
+
+// SecurityPosture reports the status of the Linux security modules and kernel
+// hardening features.
+func (h *host) SecurityPosture() (*types.SecurityPostureInfo, error) {
+	return readSecurityPosture(h.procFS)
+}
+
 // VMStat reports data from /proc/vmstat on linux.
 func (h *host) VMStat() (*types.VMStatInfo, error) {
 	path := h.procFS.path("vmstat")
@@ -134,6 +218,52 @@ func (h *host) NetworkCounters() (*types.NetworkCountersInfo, error) {
 	return &types.NetworkCountersInfo{SNMP: snmp, Netstat: netstat}, nil
 }
 
+// Routes reports the routing tables from /proc/net/route and
+// /proc/net/ipv6_route on linux.
+func (h *host) Routes() ([]types.Route, error) {
+	return readRoutes(h.procFS.path("net/route"), h.procFS.path("net/ipv6_route"))
+}
+
+// Interrupts reports data from /proc/interrupts, /proc/softirqs and the
+// activity counters of /proc/stat on linux.
+func (h *host) Interrupts() (*types.InterruptsInfo, error) {
+	path := h.procFS.path("interrupts")
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading interrupts file %s: %w", path, err)
+	}
+	irqs, err := parseInterrupts(content)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing interrupts file %s: %w", path, err)
+	}
+
+	path = h.procFS.path("softirqs")
+	content, err = os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("error reading softirqs file %s: %w", path, err)
+	}
+	softirqs, err := parseSoftIRQs(content)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing softirqs file %s: %w", path, err)
+	}
+
+	stat, err := h.procFS.Stat()
+	if err != nil {
+		return nil, fmt.Errorf("error fetching stat counters: %w", err)
+	}
+
+	return &types.InterruptsInfo{
+		IRQs:             irqs,
+		SoftIRQs:         softirqs,
+		IRQTotal:         stat.IRQTotal,
+		SoftIRQTotal:     stat.SoftIRQTotal,
+		ContextSwitches:  stat.ContextSwitches,
+		ProcessesCreated: stat.ProcessCreated,
+		ProcessesRunning: stat.ProcessesRunning,
+		ProcessesBlocked: stat.ProcessesBlocked,
+	}, nil
+}
+
 // CPUTime returns host CPU usage metrics
 func (h *host) CPUTime() (types.CPUTimes, error) {
 	stat, err := h.procFS.Stat()
@@ -164,6 +294,7 @@ func newHost(fs procFS) (*host, error) {
 	r.architecture(h)
 	r.nativeArchitecture(h)
 	r.bootTime(h)
+	r.boot(h)
 	r.containerized(h)
 	r.hostname(h)
 	r.network(h)
@@ -220,6 +351,14 @@ func (r *reader) bootTime(h *host) {
 	h.info.BootTime = v
 }
 
+func (r *reader) boot(h *host) {
+	v, err := readBootInfo(h.procFS)
+	if r.addErr(err) {
+		return
+	}
+	h.info.Boot = v
+}
+
 func (r *reader) containerized(h *host) {
 	v, err := IsContainerized()
 	if r.addErr(err) {
@@ -277,9 +416,16 @@ type procFS struct {
 	procfs.FS
 	mountPoint string
 	baseMount  string
+	bootID     *bootIDCache // Shared by all copies of the procFS.
 }
 
 func (fs *procFS) path(p ...string) string {
 	elem := append([]string{fs.mountPoint}, p...)
 	return filepath.Join(elem...)
 }
+
+// rootPath returns the path of p relative to the root of the host filesystem.
+func (fs *procFS) rootPath(p ...string) string {
+	elem := append([]string{fs.baseMount, "/"}, p...)
+	return filepath.Join(elem...)
+}
@@ -55,6 +55,49 @@ func TestHostMemoryInfo(t *testing.T) {
 	assert.Contains(t, m.Metrics, "Slab")
 }
 
+func TestHostMemInfoDetail(t *testing.T) {
+	host, err := newLinuxSystem("testdata/ubuntu1710").Host()
+	if err != nil {
+		t.Fatal(err)
+	}
+	m, err := host.(types.MemInfo).MemInfo()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.EqualValues(t, 4139057152, m.MemTotal)
+	assert.EqualValues(t, 33316*1024, m.Buffers)
+	assert.EqualValues(t, 1248984*1024, m.Cached)
+	assert.EqualValues(t, 49852*1024, m.SReclaimable)
+	assert.EqualValues(t, 111080*1024, m.ActiveAnon)
+	assert.EqualValues(t, 418744*1024, m.CommittedAS)
+	assert.EqualValues(t, 2048*1024, m.Hugepagesize)
+	assert.EqualValues(t, 3145728*1024, m.DirectMap1G)
+	assert.Empty(t, m.Other)
+
+	// MemTotal - MemFree - Buffers - Cached - SReclaimable
+	assert.EqualValues(t, (4042048-2551468-33316-1248984-49852)*1024, m.UsedExcludingCache())
+}
+
+func TestParseMemInfoDetailUnknownKeys(t *testing.T) {
+	m, err := parseMemInfoDetail([]byte("MemTotal:    1024 kB\nMemFree:  2048 kB\nHugePages_Total:  4\nFutureField:   8 kB\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.EqualValues(t, 1024*1024, m.MemTotal)
+	assert.EqualValues(t, 4, m.HugePagesTotal)
+	assert.Equal(t, map[string]uint64{"FutureField": 8 * 1024}, m.Other)
+
+	// Free exceeding the total must not underflow.
+	assert.EqualValues(t, 0, m.UsedExcludingCache())
+}
+
+func TestParseMemInfoDetailInvalid(t *testing.T) {
+	_, err := parseMemInfoDetail([]byte("MemTotal:    1024 kB\nMemFree:  abc kB\n"))
+	assert.ErrorContains(t, err, "failed to parse MemFree value")
+}
+
 func TestHostVMStat(t *testing.T) {
 	host, err := newLinuxSystem("testdata/ubuntu1710").Host()
 	if err != nil {
@@ -110,3 +153,26 @@ func TestHostNetworkCounters(t *testing.T) {
 	}
 	t.Log(string(data))
 }
+
+func TestHostDNSConfig(t *testing.T) {
+	host, err := newLinuxSystem("testdata/ubuntu1710").Host()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dns, err := host.(types.DNSConfig).DNSConfig()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if assert.NotNil(t, dns.ResolvConf) {
+		assert.Equal(t, []string{"127.0.0.53"}, dns.ResolvConf.Nameservers)
+		assert.Equal(t, []string{"example.com"}, dns.ResolvConf.Search)
+	}
+	if assert.NotNil(t, dns.SystemdResolved) {
+		assert.Equal(t, []string{"10.0.2.3", "fe80::1%enp0s3"}, dns.SystemdResolved.Nameservers)
+	}
+	assert.Equal(t, []string{"files", "mdns4_minimal", "[NOTFOUND=return]", "resolve", "[!UNAVAIL=return]", "dns", "myhostname"}, dns.NSSwitchHosts)
+	assert.Len(t, dns.Hosts, 7)
+	assert.Equal(t, types.HostsEntry{IP: "127.0.1.1", Hostnames: []string{"ubuntu1710.example.com", "ubuntu1710"}}, dns.Hosts[1])
+}
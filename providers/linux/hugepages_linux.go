@@ -0,0 +1,152 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package linux
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/elastic/go-sysinfo/types"
+)
+
+const (
+	sysHugePagesPath   = "sys/kernel/mm/hugepages"
+	sysTransparentPath = "sys/kernel/mm/transparent_hugepage"
+)
+
+// readHugePages reads the huge page pools from hugePagesDir
+// (/sys/kernel/mm/hugepages) and their per-node counters from nodeDir
+// (/sys/devices/system/node).
+func readHugePages(hugePagesDir, nodeDir string) ([]types.HugePagePool, error) {
+	dirs, err := filepath.Glob(filepath.Join(hugePagesDir, "hugepages-*kB"))
+	if err != nil {
+		return nil, err
+	}
+
+	pools := make([]types.HugePagePool, 0, len(dirs))
+	for _, dir := range dirs {
+		pageSize, err := parseHugePagesDirName(filepath.Base(dir))
+		if err != nil {
+			return nil, err
+		}
+
+		pool := types.HugePagePool{PageSize: pageSize}
+		for file, dst := range map[string]*uint64{
+			"nr_hugepages":            &pool.Total,
+			"free_hugepages":          &pool.Free,
+			"resv_hugepages":          &pool.Reserved,
+			"surplus_hugepages":       &pool.Surplus,
+			"nr_overcommit_hugepages": &pool.Overcommit,
+		} {
+			if *dst, err = readUint(filepath.Join(dir, file)); err != nil {
+				return nil, fmt.Errorf("error reading huge pages: %w", err)
+			}
+		}
+
+		if pool.Nodes, err = readNodeHugePages(nodeDir, filepath.Base(dir)); err != nil {
+			return nil, err
+		}
+
+		pools = append(pools, pool)
+	}
+
+	sort.Slice(pools, func(i, j int) bool { return pools[i].PageSize < pools[j].PageSize })
+	return pools, nil
+}
+
+// readNodeHugePages reads the per-node counters of the huge page pool with
+// the given directory name. It returns nil if NUMA is not available.
+func readNodeHugePages(nodeDir, poolDir string) ([]types.HugePageNodePool, error) {
+	dirs, err := filepath.Glob(filepath.Join(nodeDir, "node[0-9]*", "hugepages", poolDir))
+	if err != nil {
+		return nil, err
+	}
+
+	var nodes []types.HugePageNodePool
+	for _, dir := range dirs {
+		id, err := strconv.Atoi(strings.TrimPrefix(filepath.Base(filepath.Dir(filepath.Dir(dir))), "node"))
+		if err != nil {
+			continue
+		}
+
+		node := types.HugePageNodePool{Node: id}
+		for file, dst := range map[string]*uint64{
+			"nr_hugepages":      &node.Total,
+			"free_hugepages":    &node.Free,
+			"surplus_hugepages": &node.Surplus,
+		} {
+			if *dst, err = readUint(filepath.Join(dir, file)); err != nil {
+				return nil, fmt.Errorf("error reading huge pages of NUMA node %d: %w", id, err)
+			}
+		}
+
+		nodes = append(nodes, node)
+	}
+
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i].Node < nodes[j].Node })
+	return nodes, nil
+}
+
+// parseHugePagesDirName returns the page size in bytes of a pool directory
+// name like hugepages-2048kB.
+func parseHugePagesDirName(name string) (uint64, error) {
+	kb, err := strconv.ParseUint(strings.TrimSuffix(strings.TrimPrefix(name, "hugepages-"), "kB"), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse huge page size of %v: %w", name, err)
+	}
+
+	return kb * 1024, nil
+}
+
+// readTransparentHugePages reads the selected transparent huge page modes
+// from dir (/sys/kernel/mm/transparent_hugepage). It returns nil if
+// transparent huge pages are not supported by the kernel.
+func readTransparentHugePages(dir string) (*types.TransparentHugePages, error) {
+	enabled, err := os.ReadFile(filepath.Join(dir, "enabled"))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	defrag, err := os.ReadFile(filepath.Join(dir, "defrag"))
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+
+	return &types.TransparentHugePages{
+		Enabled: selectedMode(string(enabled)),
+		Defrag:  selectedMode(string(defrag)),
+	}, nil
+}
+
+// selectedMode returns the bracketed value from a sysfs mode list such as
+// "always [madvise] never".
+func selectedMode(s string) string {
+	for _, f := range strings.Fields(s) {
+		if strings.HasPrefix(f, "[") && strings.HasSuffix(f, "]") {
+			return strings.Trim(f, "[]")
+		}
+	}
+	return ""
+}
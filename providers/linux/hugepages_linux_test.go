@@ -0,0 +1,81 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package linux
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/go-sysinfo/types"
+)
+
+func TestHostHugePages(t *testing.T) {
+	host, err := newLinuxSystem("testdata/fedora30").Host()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	info, err := host.(types.HugePages).HugePages()
+	require.NoError(t, err)
+
+	assert.Equal(t, []types.HugePagePool{
+		{
+			PageSize:   2048 * 1024,
+			Total:      512,
+			Free:       300,
+			Reserved:   12,
+			Surplus:    4,
+			Overcommit: 64,
+			Nodes: []types.HugePageNodePool{
+				{Node: 0, Total: 256, Free: 100, Surplus: 4},
+				{Node: 1, Total: 256, Free: 200},
+			},
+		},
+		{
+			PageSize: 1048576 * 1024,
+			Total:    2,
+			Free:     2,
+			Nodes: []types.HugePageNodePool{
+				{Node: 0, Total: 1, Free: 1},
+				{Node: 1, Total: 1, Free: 1},
+			},
+		},
+	}, info.Pools)
+
+	assert.Equal(t, &types.TransparentHugePages{Enabled: "madvise", Defrag: "madvise"}, info.Transparent)
+}
+
+func TestHostHugePagesNotSupported(t *testing.T) {
+	host, err := newLinuxSystem("testdata/ubuntu1710").Host()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	info, err := host.(types.HugePages).HugePages()
+	require.NoError(t, err)
+	assert.Empty(t, info.Pools)
+	assert.Nil(t, info.Transparent)
+}
+
+func TestSelectedMode(t *testing.T) {
+	assert.Equal(t, "always", selectedMode("[always] madvise never\n"))
+	assert.Equal(t, "defer+madvise", selectedMode("always defer [defer+madvise] madvise never"))
+	assert.Equal(t, "", selectedMode("always madvise never"))
+}
@@ -0,0 +1,138 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package linux
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/elastic/go-sysinfo/types"
+)
+
+// parseInterrupts parses the contents of /proc/interrupts. The header line
+// contains one column per online CPU. Numbered IRQs are followed by the chip
+// name, the hardware IRQ (with the trigger type appended on x86) and the
+// comma separated list of devices. Named interrupts are followed by a
+// description.
+func parseInterrupts(content []byte) ([]types.IRQ, error) {
+	sc := bufio.NewScanner(bytes.NewReader(content))
+	if !sc.Scan() {
+		return nil, errors.New("interrupts file is empty")
+	}
+	numCPU := len(strings.Fields(sc.Text()))
+
+	var irqs []types.IRQ
+	for sc.Scan() {
+		fields := strings.Fields(sc.Text())
+		if len(fields) == 0 {
+			continue
+		}
+
+		name, ok := strings.CutSuffix(fields[0], ":")
+		if !ok {
+			return nil, fmt.Errorf("unexpected line format %q", sc.Text())
+		}
+
+		irq := types.IRQ{Name: name}
+		fields = fields[1:]
+		for len(fields) > 0 && len(irq.PerCPU) < numCPU {
+			v, err := strconv.ParseUint(fields[0], 10, 64)
+			if err != nil {
+				break
+			}
+			irq.PerCPU = append(irq.PerCPU, v)
+			fields = fields[1:]
+		}
+
+		if _, err := strconv.Atoi(name); err != nil {
+			irq.Description = strings.Join(fields, " ")
+			irqs = append(irqs, irq)
+			continue
+		}
+
+		if len(fields) > 0 {
+			irq.Chip, fields = fields[0], fields[1:]
+		}
+		if len(fields) > 0 {
+			irq.HWIRQ, irq.Trigger, _ = strings.Cut(fields[0], "-")
+			fields = fields[1:]
+		}
+		if len(fields) > 0 && isIRQTrigger(fields[0]) {
+			irq.Trigger, fields = strings.ToLower(fields[0]), fields[1:]
+		}
+		if len(fields) > 0 {
+			for _, dev := range strings.Split(strings.Join(fields, " "), ",") {
+				if dev = strings.TrimSpace(dev); dev != "" {
+					irq.Devices = append(irq.Devices, dev)
+				}
+			}
+		}
+
+		irqs = append(irqs, irq)
+	}
+
+	return irqs, sc.Err()
+}
+
+// isIRQTrigger reports whether s is a trigger type column as printed by
+// interrupt controllers that do not append it to the hardware IRQ (e.g. GIC).
+func isIRQTrigger(s string) bool {
+	switch s {
+	case "Level", "Edge":
+		return true
+	}
+	return false
+}
+
+// parseSoftIRQs parses the contents of /proc/softirqs.
+func parseSoftIRQs(content []byte) ([]types.SoftIRQ, error) {
+	sc := bufio.NewScanner(bytes.NewReader(content))
+	if !sc.Scan() {
+		return nil, errors.New("softirqs file is empty")
+	}
+
+	var softirqs []types.SoftIRQ
+	for sc.Scan() {
+		fields := strings.Fields(sc.Text())
+		if len(fields) == 0 {
+			continue
+		}
+
+		name, ok := strings.CutSuffix(fields[0], ":")
+		if !ok {
+			return nil, fmt.Errorf("unexpected line format %q", sc.Text())
+		}
+
+		softirq := types.SoftIRQ{Name: name, PerCPU: make([]uint64, 0, len(fields)-1)}
+		for _, f := range fields[1:] {
+			v, err := strconv.ParseUint(f, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse %v count: %w", name, err)
+			}
+			softirq.PerCPU = append(softirq.PerCPU, v)
+		}
+
+		softirqs = append(softirqs, softirq)
+	}
+
+	return softirqs, sc.Err()
+}
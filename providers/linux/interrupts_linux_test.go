@@ -0,0 +1,85 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package linux
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/go-sysinfo/types"
+)
+
+func TestHostInterrupts(t *testing.T) {
+	host, err := newLinuxSystem("testdata/fedora30").Host()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	info, err := host.(types.Interrupts).Interrupts()
+	require.NoError(t, err)
+
+	require.Len(t, info.IRQs, 13)
+	assert.Equal(t, types.IRQ{
+		Name:    "16",
+		PerCPU:  []uint64{32, 0},
+		Chip:    "IO-APIC",
+		HWIRQ:   "16",
+		Trigger: "fasteoi",
+		Devices: []string{"ehci_hcd:usb1", "i801_smbus"},
+	}, info.IRQs[4])
+	assert.Equal(t, "327680", info.IRQs[7].HWIRQ)
+	assert.Equal(t, types.IRQ{
+		Name:        "LOC",
+		PerCPU:      []uint64{271532881, 263902736},
+		Description: "Local timer interrupts",
+	}, info.IRQs[9])
+	assert.Equal(t, types.IRQ{Name: "ERR", PerCPU: []uint64{0}}, info.IRQs[11])
+	assert.EqualValues(t, 271532881+263902736, info.IRQs[9].Total())
+
+	require.Len(t, info.SoftIRQs, 10)
+	assert.Equal(t, types.SoftIRQ{Name: "NET_RX", PerCPU: []uint64{10428720, 10307768}}, info.SoftIRQs[3])
+
+	assert.EqualValues(t, 3649757765, info.IRQTotal)
+	assert.EqualValues(t, 1934569580, info.SoftIRQTotal)
+	assert.EqualValues(t, 5607775026, info.ContextSwitches)
+	assert.EqualValues(t, 4891735, info.ProcessesCreated)
+	assert.EqualValues(t, 1, info.ProcessesRunning)
+	assert.EqualValues(t, 0, info.ProcessesBlocked)
+}
+
+func TestParseInterruptsGIC(t *testing.T) {
+	irqs, err := parseInterrupts([]byte(`           CPU0       CPU1       CPU2       CPU3
+ 11:    9218727    7614356    7830184    7585312     GICv3  27 Level     arch_timer
+ 14:          0          0          0          0     GICv3  37 Level     uart-pl011
+IPI0:     1240118    1318340    1279620    1270339       Rescheduling interrupts
+`))
+	require.NoError(t, err)
+	require.Len(t, irqs, 3)
+
+	assert.Equal(t, types.IRQ{
+		Name:    "11",
+		PerCPU:  []uint64{9218727, 7614356, 7830184, 7585312},
+		Chip:    "GICv3",
+		HWIRQ:   "27",
+		Trigger: "level",
+		Devices: []string{"arch_timer"},
+	}, irqs[0])
+	assert.Equal(t, "Rescheduling interrupts", irqs[2].Description)
+}
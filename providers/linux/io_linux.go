@@ -0,0 +1,73 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package linux
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/elastic/go-sysinfo/types"
+)
+
+// readIOCounters reads the I/O accounting counters from /proc/[pid]/io.
+func readIOCounters(path string, pid int) (*types.IOCountersInfo, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, os.ErrPermission) {
+			// The file is protected by a PTRACE_MODE_READ_FSCREDS check.
+			return nil, fmt.Errorf("reading I/O counters of process %d requires ptrace access to it: %w", pid, err)
+		}
+		return nil, fmt.Errorf("error reading io file %s: %w", path, err)
+	}
+
+	return parseIOCounters(content)
+}
+
+func parseIOCounters(content []byte) (*types.IOCountersInfo, error) {
+	var io types.IOCountersInfo
+
+	counters := map[string]*uint64{
+		"rchar":                 &io.ReadChars,
+		"wchar":                 &io.WriteChars,
+		"syscr":                 &io.ReadSyscalls,
+		"syscw":                 &io.WriteSyscalls,
+		"read_bytes":            &io.ReadBytes,
+		"write_bytes":           &io.WriteBytes,
+		"cancelled_write_bytes": &io.CancelledWriteBytes,
+	}
+
+	err := parseKeyValue(content, ':', func(key, value []byte) error {
+		dst, found := counters[string(key)]
+		if !found {
+			return nil
+		}
+		v, err := strconv.ParseUint(string(value), 10, 64)
+		if err != nil {
+			return err
+		}
+		*dst = v
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error parsing io data: %w", err)
+	}
+
+	return &io, nil
+}
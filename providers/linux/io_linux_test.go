@@ -0,0 +1,62 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package linux
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/go-sysinfo/types"
+)
+
+func TestProcessIOCounters(t *testing.T) {
+	proc, err := newLinuxSystem("testdata/fedora40").Process(33925)
+	require.NoError(t, err)
+
+	io, err := proc.(types.IOCounters).IOCounters()
+	require.NoError(t, err)
+
+	assert.Equal(t, &types.IOCountersInfo{
+		ReadChars:           1273912040,
+		WriteChars:          268129354,
+		ReadSyscalls:        402716,
+		WriteSyscalls:       98211,
+		ReadBytes:           52191232,
+		WriteBytes:          114790400,
+		CancelledWriteBytes: 2170880,
+	}, io)
+}
+
+func TestProcessIOCountersSelf(t *testing.T) {
+	proc, err := newLinuxSystem("").Self()
+	require.NoError(t, err)
+
+	io, err := proc.(types.IOCounters).IOCounters()
+	require.NoError(t, err)
+	assert.NotZero(t, io.ReadSyscalls)
+}
+
+func TestParseIOCountersInvalid(t *testing.T) {
+	_, err := parseIOCounters([]byte("rchar: abc\nwchar: 10\n"))
+	assert.ErrorContains(t, err, "error parsing io data")
+
+	_, err = parseIOCounters([]byte("rchar 10\n"))
+	assert.Error(t, err)
+}
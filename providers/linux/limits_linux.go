@@ -0,0 +1,110 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package linux
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/elastic/go-sysinfo/types"
+)
+
+// parseResourceLimits parses the contents of /proc/[pid]/limits. The file
+// contains a table with the columns Limit, Soft Limit, Hard Limit and Units:
+//
+//	Limit                     Soft Limit           Hard Limit           Units
+//	Max cpu time              unlimited            unlimited            seconds
+//	Max open files            1024                 524288               files
+//
+// Rows that are missing are left nil, except for "Max open files" which is
+// reported by all kernels and is required.
+func parseResourceLimits(content []byte) (*types.ResourceLimitsInfo, error) {
+	var limits types.ResourceLimitsInfo
+
+	names := []struct {
+		name  string
+		limit **types.ResourceLimit
+	}{
+		{"Max cpu time", &limits.CPU},
+		{"Max file size", &limits.FileSize},
+		{"Max data size", &limits.Data},
+		{"Max stack size", &limits.Stack},
+		{"Max core file size", &limits.Core},
+		{"Max resident set", &limits.RSS},
+		{"Max processes", &limits.Processes},
+		{"Max open files", &limits.OpenFiles},
+		{"Max locked memory", &limits.MemLock},
+		{"Max address space", &limits.AddrSpace},
+		{"Max file locks", &limits.FileLocks},
+		{"Max pending signals", &limits.SigPending},
+		{"Max msgqueue size", &limits.MsgQueue},
+		{"Max nice priority", &limits.Nice},
+		{"Max realtime priority", &limits.RTPriority},
+		{"Max realtime timeout", &limits.RTTime},
+	}
+
+	var line []byte
+	for len(content) > 0 {
+		line, content, _ = bytes.Cut(content, []byte{'\n'})
+		s := string(line)
+
+		for _, n := range names {
+			if !strings.HasPrefix(s, n.name+" ") {
+				continue
+			}
+
+			// The remaining columns are the soft and hard limit followed by
+			// the optional unit.
+			fields := strings.Fields(s[len(n.name):])
+			if len(fields) < 2 {
+				return nil, fmt.Errorf("invalid limits line %q", s)
+			}
+			var limit types.ResourceLimit
+			var err error
+			if limit.Soft, limit.SoftUnlimited, err = parseRLimit(fields[0]); err != nil {
+				return nil, fmt.Errorf("failed to parse soft limit of %v: %w", n.name, err)
+			}
+			if limit.Hard, limit.HardUnlimited, err = parseRLimit(fields[1]); err != nil {
+				return nil, fmt.Errorf("failed to parse hard limit of %v: %w", n.name, err)
+			}
+			*n.limit = &limit
+			break
+		}
+	}
+
+	if limits.OpenFiles == nil {
+		return nil, errors.New("limits do not contain Max open files")
+	}
+	return &limits, nil
+}
+
+// parseRLimit parses a limit value. The second return value is true for
+// unlimited.
+func parseRLimit(s string) (uint64, bool, error) {
+	if s == "unlimited" {
+		return 0, true, nil
+	}
+	v, err := strconv.ParseUint(s, 10, 64)
+	if err != nil {
+		return 0, false, err
+	}
+	return v, false, nil
+}
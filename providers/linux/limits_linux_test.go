@@ -0,0 +1,121 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package linux
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sys/unix"
+
+	"github.com/elastic/go-sysinfo/types"
+)
+
+func limit(soft, hard uint64) *types.ResourceLimit {
+	return &types.ResourceLimit{Soft: soft, Hard: hard}
+}
+
+func unlimited() *types.ResourceLimit {
+	return &types.ResourceLimit{SoftUnlimited: true, HardUnlimited: true}
+}
+
+// rlimitValue converts getrlimit(2) values to a ResourceLimit.
+func rlimitValue(rlimit unix.Rlimit) *types.ResourceLimit {
+	var l types.ResourceLimit
+	if rlimit.Cur == unix.RLIM_INFINITY {
+		l.SoftUnlimited = true
+	} else {
+		l.Soft = rlimit.Cur
+	}
+	if rlimit.Max == unix.RLIM_INFINITY {
+		l.HardUnlimited = true
+	} else {
+		l.Hard = rlimit.Max
+	}
+	return &l
+}
+
+func TestProcessResourceLimits(t *testing.T) {
+	proc, err := newLinuxSystem("testdata/fedora40").Process(33925)
+	require.NoError(t, err)
+
+	limits, err := proc.(types.ResourceLimits).ResourceLimits()
+	require.NoError(t, err)
+
+	assert.Equal(t, &types.ResourceLimitsInfo{
+		CPU:        unlimited(),
+		FileSize:   unlimited(),
+		Data:       unlimited(),
+		Stack:      &types.ResourceLimit{Soft: 8388608, HardUnlimited: true},
+		Core:       unlimited(),
+		RSS:        unlimited(),
+		Processes:  limit(127213, 127213),
+		OpenFiles:  limit(1024, 524288),
+		MemLock:    limit(8388608, 8388608),
+		AddrSpace:  unlimited(),
+		FileLocks:  unlimited(),
+		SigPending: limit(127213, 127213),
+		MsgQueue:   limit(819200, 819200),
+		Nice:       limit(0, 0),
+		RTPriority: limit(0, 0),
+		RTTime:     unlimited(),
+	}, limits)
+	assert.False(t, limits.Stack.IsSoftUnlimited())
+	assert.True(t, limits.Stack.IsHardUnlimited())
+
+	usage, err := types.OpenFilesUsage(proc)
+	require.NoError(t, err)
+	assert.InDelta(t, 5.0/1024, usage, 1e-9)
+}
+
+func TestParseResourceLimitsInvalid(t *testing.T) {
+	_, err := parseResourceLimits([]byte("Max open files            many                 524288               files\n"))
+	assert.Error(t, err)
+
+	// Max open files is required.
+	_, err = parseResourceLimits([]byte("Max cpu time              unlimited            unlimited            seconds\n"))
+	assert.Error(t, err)
+}
+
+func TestParseResourceLimitsMissingRow(t *testing.T) {
+	limits, err := parseResourceLimits([]byte(
+		"Limit                     Soft Limit           Hard Limit           Units\n" +
+			"Max open files            1024                 524288               files\n"))
+	require.NoError(t, err)
+
+	// A row that is not reported is not unlimited.
+	assert.Nil(t, limits.CPU)
+	assert.Equal(t, limit(1024, 524288), limits.OpenFiles)
+}
+
+func TestProcessResourceLimitsSelf(t *testing.T) {
+	proc, err := newLinuxSystem("").Self()
+	require.NoError(t, err)
+
+	limits, err := proc.(types.ResourceLimits).ResourceLimits()
+	require.NoError(t, err)
+
+	var rlimit unix.Rlimit
+	require.NoError(t, unix.Getrlimit(unix.RLIMIT_NOFILE, &rlimit))
+	assert.Equal(t, rlimitValue(rlimit), limits.OpenFiles)
+
+	usage, err := types.OpenFilesUsage(proc)
+	require.NoError(t, err)
+	assert.Greater(t, usage, 0.0)
+}
@@ -0,0 +1,118 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package linux
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/elastic/go-sysinfo/types"
+)
+
+// parseMemoryMaps parses the contents of /proc/[pid]/maps. Each line has
+// the format:
+//
+//	address           perms offset  dev   inode      pathname
+//	00400000-00452000 r-xp 00000000 08:02 173521     /usr/bin/dbus-daemon
+func parseMemoryMaps(content []byte) ([]types.MemoryMap, error) {
+	var (
+		maps []types.MemoryMap
+		line []byte
+	)
+	for len(content) > 0 {
+		line, content, _ = bytes.Cut(content, []byte{'\n'})
+		if len(bytes.TrimSpace(line)) == 0 {
+			continue
+		}
+
+		m, err := parseMemoryMap(string(line))
+		if err != nil {
+			return nil, fmt.Errorf("error parsing maps line %q: %w", line, err)
+		}
+		maps = append(maps, m)
+	}
+	return maps, nil
+}
+
+func parseMemoryMap(line string) (types.MemoryMap, error) {
+	var m types.MemoryMap
+
+	// The pathname is the remainder after the first five fields. It is
+	// not split because file names may contain spaces.
+	rest := line
+	fields := make([]string, 0, 5)
+	for i := 0; i < 5; i++ {
+		rest = strings.TrimLeft(rest, " ")
+		var field string
+		field, rest, _ = strings.Cut(rest, " ")
+		if field == "" {
+			return m, fmt.Errorf("expected at least 5 fields")
+		}
+		fields = append(fields, field)
+	}
+	m.Path = strings.TrimLeft(rest, " ")
+
+	start, end, found := strings.Cut(fields[0], "-")
+	if !found {
+		return m, fmt.Errorf("invalid address range %q", fields[0])
+	}
+	var err error
+	if m.StartAddress, err = strconv.ParseUint(start, 16, 64); err != nil {
+		return m, err
+	}
+	if m.EndAddress, err = strconv.ParseUint(end, 16, 64); err != nil {
+		return m, err
+	}
+	m.Perms = fields[1]
+	if m.Offset, err = strconv.ParseUint(fields[2], 16, 64); err != nil {
+		return m, err
+	}
+	m.Device = fields[3]
+	if m.Inode, err = strconv.ParseUint(fields[4], 10, 64); err != nil {
+		return m, err
+	}
+	m.Kind = memoryMapKind(m.Path)
+
+	return m, nil
+}
+
+// memoryMapKind classifies a region based on its pathname.
+func memoryMapKind(path string) string {
+	switch {
+	case path == "[heap]":
+		return types.MemoryMapHeap
+	case path == "[stack]" || strings.HasPrefix(path, "[stack:"):
+		// [stack:<tid>] was reported for thread stacks before Linux 4.5.
+		return types.MemoryMapStack
+	case path == "[vdso]" || path == "[vsyscall]" || strings.HasPrefix(path, "[vvar"):
+		return types.MemoryMapVDSO
+	case path == "" || strings.HasPrefix(path, "["):
+		// Includes named anonymous regions like [anon:name].
+		return types.MemoryMapAnon
+	case !strings.HasPrefix(path, "/"):
+		// Pseudo files like anon_inode:[perf_event].
+		return types.MemoryMapAnon
+	case strings.HasPrefix(path, "/memfd:"):
+		// Anonymous file created with memfd_create(2).
+		return types.MemoryMapMemFD
+	default:
+		return types.MemoryMapFile
+	}
+}
@@ -0,0 +1,106 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package linux
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/go-sysinfo/types"
+)
+
+func TestProcessMemoryMaps(t *testing.T) {
+	proc, err := newLinuxSystem("testdata/fedora40").Process(33925)
+	require.NoError(t, err)
+
+	maps, err := proc.(types.MemoryMaps).MemoryMaps()
+	require.NoError(t, err)
+	require.Len(t, maps, 16)
+
+	assert.Equal(t, types.MemoryMap{
+		StartAddress: 0x55d6a3c50000,
+		EndAddress:   0x55d6a3c51000,
+		Perms:        "r-xp",
+		Offset:       0x2000,
+		Device:       "fd:00",
+		Inode:        1311139,
+		Path:         "/usr/bin/python3.12",
+		Kind:         types.MemoryMapFile,
+	}, maps[1])
+	assert.EqualValues(t, 0x1000, maps[1].Size())
+
+	kinds := make([]string, 0, len(maps))
+	for _, m := range maps {
+		kinds = append(kinds, m.Kind)
+	}
+	assert.Equal(t, []string{
+		types.MemoryMapFile,
+		types.MemoryMapFile,
+		types.MemoryMapHeap,
+		types.MemoryMapAnon,
+		types.MemoryMapMemFD,
+		types.MemoryMapFile,
+		types.MemoryMapFile,
+		types.MemoryMapFile,
+		types.MemoryMapFile,
+		types.MemoryMapMemFD,
+		types.MemoryMapVDSO,
+		types.MemoryMapVDSO,
+		types.MemoryMapFile,
+		types.MemoryMapFile,
+		types.MemoryMapStack,
+		types.MemoryMapVDSO,
+	}, kinds)
+	assert.Equal(t, "/memfd:shared buffer (deleted)", maps[4].Path)
+	assert.Empty(t, maps[3].Path)
+
+	assert.Equal(t, []string{
+		"/usr/lib64/libc.so.6",
+		"/usr/lib64/python3.12/lib-dynload/_ssl.cpython-312-x86_64-linux-gnu.so",
+		"/tmp/inject.so (deleted)",
+		"/memfd:evil.so (deleted)",
+		"/usr/lib64/ld-linux-x86-64.so.2",
+	}, types.SharedObjects(maps))
+}
+
+func TestParseMemoryMapsInvalid(t *testing.T) {
+	_, err := parseMemoryMaps([]byte("55d6a3c4e000 r--p 00000000 fd:00 1311139 /usr/bin/python3.12\n"))
+	assert.Error(t, err)
+
+	_, err = parseMemoryMaps([]byte("55d6a3c4e000-55d6a3c50000 r--p\n"))
+	assert.Error(t, err)
+}
+
+func TestProcessMemoryMapsSelf(t *testing.T) {
+	proc, err := newLinuxSystem("").Self()
+	require.NoError(t, err)
+
+	maps, err := proc.(types.MemoryMaps).MemoryMaps()
+	require.NoError(t, err)
+	require.NotEmpty(t, maps)
+
+	var hasStack bool
+	for _, m := range maps {
+		if m.Kind == types.MemoryMapStack {
+			hasStack = true
+		}
+	}
+	assert.True(t, hasStack, "no [stack] mapping found")
+}
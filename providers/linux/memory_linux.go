@@ -19,10 +19,24 @@ package linux
 
 import (
 	"fmt"
+	"reflect"
 
 	"github.com/elastic/go-sysinfo/types"
 )
 
+// meminfoTagToFieldIndex contains a mapping of meminfo struct tags to struct field indices.
+var meminfoTagToFieldIndex = make(map[string]int)
+
+func init() {
+	typ := reflect.TypeOf(types.MemInfoDetail{})
+
+	for i := 0; i < typ.NumField(); i++ {
+		if tag := typ.Field(i).Tag.Get("meminfo"); tag != "" {
+			meminfoTagToFieldIndex[tag] = i
+		}
+	}
+}
+
 func parseMemInfo(content []byte) (*types.HostMemoryInfo, error) {
 	memInfo := &types.HostMemoryInfo{
 		Metrics: map[string]uint64{},
@@ -70,3 +84,33 @@ func parseMemInfo(content []byte) (*types.HostMemoryInfo, error) {
 
 	return memInfo, nil
 }
+
+// parseMemInfoDetail parses the contents of /proc/meminfo into the typed
+// MemInfoDetail. Keys without a typed field are stored in Other.
+func parseMemInfoDetail(content []byte) (*types.MemInfoDetail, error) {
+	detail := &types.MemInfoDetail{
+		Other: map[string]uint64{},
+	}
+	refValues := reflect.ValueOf(detail).Elem()
+
+	err := parseKeyValue(content, ':', func(key, value []byte) error {
+		num, err := parseBytesOrNumber(value)
+		if err != nil {
+			return fmt.Errorf("failed to parse %v value of %v: %w", string(key), string(value), err)
+		}
+
+		idx, ok := meminfoTagToFieldIndex[string(key)]
+		if !ok {
+			detail.Other[string(key)] = num
+			return nil
+		}
+
+		refValues.Field(idx).SetUint(num)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return detail, nil
+}
@@ -0,0 +1,83 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package linux
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/go-sysinfo/types"
+)
+
+func TestProcessNamespaces(t *testing.T) {
+	system := newLinuxSystem("testdata/fedora40")
+
+	proc, err := system.Process(33925)
+	require.NoError(t, err)
+	ns, err := proc.(types.Namespaces).Namespaces()
+	require.NoError(t, err)
+
+	assert.Equal(t, types.NamespaceInodes{
+		"cgroup":            4026531835,
+		"ipc":               4026531839,
+		"mnt":               4026532612,
+		"net":               4026532615,
+		"pid":               4026532614,
+		"pid_for_children":  4026532614,
+		"time":              4026531834,
+		"time_for_children": 4026531834,
+		"user":              4026531837,
+		"uts":               4026531838,
+	}, ns)
+
+	initProc, err := system.Process(1)
+	require.NoError(t, err)
+	initNS, err := initProc.(types.Namespaces).Namespaces()
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"mnt", "net", "pid", "pid_for_children"}, ns.Differs(initNS))
+	assert.Empty(t, initNS.Differs(initNS))
+}
+
+func TestProcessIsolatedNamespaces(t *testing.T) {
+	system := newLinuxSystem("testdata/fedora40")
+
+	proc, err := system.Process(33925)
+	require.NoError(t, err)
+	isolated, err := proc.(types.IsolatedNamespaces).IsolatedNamespaces()
+	require.NoError(t, err)
+	assert.Equal(t, []string{"mnt", "net", "pid", "pid_for_children"}, isolated)
+
+	initProc, err := system.Process(1)
+	require.NoError(t, err)
+	isolated, err = initProc.(types.IsolatedNamespaces).IsolatedNamespaces()
+	require.NoError(t, err)
+	assert.Empty(t, isolated)
+}
+
+func TestProcessNamespacesSelf(t *testing.T) {
+	proc, err := newLinuxSystem("").Self()
+	require.NoError(t, err)
+
+	ns, err := proc.(types.Namespaces).Namespaces()
+	require.NoError(t, err)
+	assert.NotZero(t, ns["net"])
+	assert.NotZero(t, ns["pid"])
+}
@@ -0,0 +1,220 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package linux
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/elastic/go-sysinfo/types"
+)
+
+const sysNodePath = "sys/devices/system/node"
+
+// readNUMANodes reads the NUMA topology from the node directories in nodeDir
+// (/sys/devices/system/node). It returns types.ErrNotImplemented if there are
+// no node directories, e.g. when the kernel is built without NUMA support or
+// /sys is not available.
+func readNUMANodes(nodeDir string) ([]types.NUMANode, error) {
+	dirs, err := filepath.Glob(filepath.Join(nodeDir, "node[0-9]*"))
+	if err != nil {
+		return nil, err
+	}
+	if len(dirs) == 0 {
+		return nil, types.ErrNotImplemented
+	}
+
+	nodes := make([]types.NUMANode, 0, len(dirs))
+	for _, dir := range dirs {
+		id, err := strconv.Atoi(strings.TrimPrefix(filepath.Base(dir), "node"))
+		if err != nil {
+			continue
+		}
+
+		node, err := readNUMANode(dir)
+		if err != nil {
+			return nil, fmt.Errorf("error reading NUMA node %d: %w", id, err)
+		}
+		node.ID = id
+		nodes = append(nodes, *node)
+	}
+
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i].ID < nodes[j].ID })
+	return nodes, nil
+}
+
+func readNUMANode(dir string) (*types.NUMANode, error) {
+	var node types.NUMANode
+
+	content, err := os.ReadFile(filepath.Join(dir, "cpulist"))
+	if err != nil {
+		return nil, err
+	}
+	if node.CPUs, err = parseCPUList(string(content)); err != nil {
+		return nil, err
+	}
+
+	content, err = os.ReadFile(filepath.Join(dir, "distance"))
+	if err != nil {
+		return nil, err
+	}
+	for _, f := range strings.Fields(string(content)) {
+		d, err := strconv.Atoi(f)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse distance: %w", err)
+		}
+		node.Distances = append(node.Distances, d)
+	}
+
+	content, err = os.ReadFile(filepath.Join(dir, "meminfo"))
+	if err != nil {
+		return nil, err
+	}
+	if node.Memory, err = parseNodeMemInfo(content); err != nil {
+		return nil, err
+	}
+
+	content, err = os.ReadFile(filepath.Join(dir, "numastat"))
+	if err != nil {
+		return nil, err
+	}
+	if node.Stat, err = parseNUMAStat(content); err != nil {
+		return nil, err
+	}
+
+	return &node, nil
+}
+
+// parseNodeMemInfo parses a per-node meminfo file. The lines have the same
+// format as /proc/meminfo prefixed by "Node <id> ".
+func parseNodeMemInfo(content []byte) (*types.MemInfoDetail, error) {
+	var buf bytes.Buffer
+
+	sc := bufio.NewScanner(bytes.NewReader(content))
+	for sc.Scan() {
+		fields := strings.SplitN(strings.TrimSpace(sc.Text()), " ", 3)
+		if len(fields) != 3 || fields[0] != "Node" {
+			continue
+		}
+		buf.WriteString(fields[2])
+		buf.WriteByte('\n')
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+
+	return parseMemInfoDetail(buf.Bytes())
+}
+
+func parseNUMAStat(content []byte) (*types.NUMAStat, error) {
+	var stat types.NUMAStat
+
+	err := parseKeyValue(content, ' ', func(key, value []byte) error {
+		num, err := strconv.ParseUint(string(value), 10, 64)
+		if err != nil {
+			return fmt.Errorf("failed to parse %v value of %v: %w", string(key), string(value), err)
+		}
+
+		switch string(key) {
+		case "numa_hit":
+			stat.NumaHit = num
+		case "numa_miss":
+			stat.NumaMiss = num
+		case "numa_foreign":
+			stat.NumaForeign = num
+		case "interleave_hit":
+			stat.InterleaveHit = num
+		case "local_node":
+			stat.LocalNode = num
+		case "other_node":
+			stat.OtherNode = num
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &stat, nil
+}
+
+// parseNUMAMaps summarizes the contents of /proc/[pid]/numa_maps per node.
+// Each line contains N<node>=<pages> tokens and the kernelpagesize_kB of
+// the mapping.
+func parseNUMAMaps(content []byte) ([]types.NUMANodeMemory, error) {
+	usage := map[int]*types.NUMANodeMemory{}
+
+	sc := bufio.NewScanner(bytes.NewReader(content))
+	for sc.Scan() {
+		var (
+			pageSize uint64
+			perNode  = map[int]uint64{}
+		)
+		for _, f := range strings.Fields(sc.Text()) {
+			key, value, ok := strings.Cut(f, "=")
+			if !ok {
+				continue
+			}
+
+			switch {
+			case key == "kernelpagesize_kB":
+				kb, err := strconv.ParseUint(value, 10, 64)
+				if err != nil {
+					return nil, fmt.Errorf("failed to parse page size %q: %w", value, err)
+				}
+				pageSize = kb * 1024
+			case len(key) > 1 && key[0] == 'N':
+				node, err := strconv.Atoi(key[1:])
+				if err != nil {
+					continue
+				}
+				pages, err := strconv.ParseUint(value, 10, 64)
+				if err != nil {
+					return nil, fmt.Errorf("failed to parse page count %q: %w", f, err)
+				}
+				perNode[node] += pages
+			}
+		}
+
+		for node, pages := range perNode {
+			u, found := usage[node]
+			if !found {
+				u = &types.NUMANodeMemory{Node: node}
+				usage[node] = u
+			}
+			u.Pages += pages
+			u.Bytes += pages * pageSize
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+
+	nodes := make([]types.NUMANodeMemory, 0, len(usage))
+	for _, u := range usage {
+		nodes = append(nodes, *u)
+	}
+	sort.Slice(nodes, func(i, j int) bool { return nodes[i].Node < nodes[j].Node })
+	return nodes, nil
+}
@@ -0,0 +1,82 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package linux
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/go-sysinfo/types"
+)
+
+func TestHostNUMA(t *testing.T) {
+	host, err := newLinuxSystem("testdata/fedora30").Host()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	nodes, err := host.(types.NUMA).NUMA()
+	require.NoError(t, err)
+	require.Len(t, nodes, 2)
+
+	assert.Equal(t, 0, nodes[0].ID)
+	assert.Equal(t, []int{0, 1, 2, 3, 8, 9, 10, 11}, nodes[0].CPUs)
+	assert.Equal(t, []int{10, 21}, nodes[0].Distances)
+	assert.EqualValues(t, 16318412*1024, nodes[0].Memory.MemTotal)
+	assert.EqualValues(t, 514288*1024, nodes[0].Memory.SReclaimable)
+	assert.Contains(t, nodes[0].Memory.Other, "MemUsed")
+	assert.EqualValues(t, 1082341913, nodes[0].Stat.NumaHit)
+	assert.EqualValues(t, 329774, nodes[0].Stat.OtherNode)
+
+	assert.Equal(t, 1, nodes[1].ID)
+	assert.Equal(t, []int{4, 5, 6, 7, 12, 13, 14, 15}, nodes[1].CPUs)
+	assert.Equal(t, []int{21, 10}, nodes[1].Distances)
+	assert.EqualValues(t, 1203, nodes[1].Stat.NumaForeign)
+}
+
+func TestHostNUMANotAvailable(t *testing.T) {
+	host, err := newLinuxSystem("testdata/ubuntu1710").Host()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = host.(types.NUMA).NUMA()
+	assert.ErrorIs(t, err, types.ErrNotImplemented)
+}
+
+func TestParseNUMAStatInvalid(t *testing.T) {
+	_, err := parseNUMAStat([]byte("numa_hit 10\nnuma_miss abc\n"))
+	assert.ErrorContains(t, err, "failed to parse numa_miss value")
+}
+
+func TestProcessNUMAMaps(t *testing.T) {
+	proc, err := newLinuxSystem("testdata/fedora40").Process(33925)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	nodes, err := proc.(types.NUMAMaps).NUMAMaps()
+	require.NoError(t, err)
+
+	assert.Equal(t, []types.NUMANodeMemory{
+		{Node: 0, Pages: 399, Bytes: 398*4096 + 2048*1024},
+		{Node: 1, Pages: 96, Bytes: 95*4096 + 2048*1024},
+	}, nodes)
+}
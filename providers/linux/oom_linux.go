@@ -0,0 +1,132 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package linux
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+
+	"golang.org/x/sys/unix"
+
+	"github.com/elastic/go-sysinfo/types"
+)
+
+// readIntFile reads a file that contains a single signed decimal integer.
+func readIntFile(path string) (int, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return 0, err
+	}
+	v, err := strconv.Atoi(strings.TrimSpace(string(content)))
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse %v: %w", path, err)
+	}
+	return v, nil
+}
+
+// readCoredumpFilter reads /proc/[pid]/coredump_filter which contains a
+// hexadecimal bitmask. The file is empty for processes without a memory
+// map, like kernel threads, in which case the second return value is false.
+func readCoredumpFilter(path string) (uint64, bool, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return 0, false, err
+	}
+	s := strings.TrimSpace(string(content))
+	if s == "" {
+		return 0, false, nil
+	}
+	v, err := strconv.ParseUint(s, 16, 64)
+	if err != nil {
+		return 0, false, fmt.Errorf("failed to parse %v: %w", path, err)
+	}
+	return v, true, nil
+}
+
+// selfDumpable returns the dumpable setting of the calling process using
+// prctl(PR_GET_DUMPABLE).
+func selfDumpable() (types.Dumpable, error) {
+	v, err := unix.PrctlRetInt(unix.PR_GET_DUMPABLE, 0, 0, 0, 0)
+	if err != nil {
+		return 0, fmt.Errorf("prctl PR_GET_DUMPABLE failed: %w", err)
+	}
+	return types.Dumpable(v), nil
+}
+
+// dumpableFromOwner derives the dumpable setting of a process from the owner
+// of its /proc/[pid] directory. When a process is not dumpable as its user,
+// the kernel assigns the directory to the root user of the user namespace of
+// the process instead of to its effective UID. Such a process is either not
+// dumpable at all or, if suid_dumpable is 2, dumpable by root only, in which
+// case nil is returned. The setting cannot be determined for processes
+// running as root or in a user namespace other than the initial one, where
+// the namespace root may be mapped to the effective UID of the process.
+func dumpableFromOwner(dir string, euid uint64, suidDumpablePath string) (*types.Dumpable, error) {
+	if euid == 0 {
+		return nil, nil
+	}
+
+	initial, err := inInitialUserNamespace(filepath.Join(dir, "uid_map"))
+	if err != nil || !initial {
+		return nil, err
+	}
+
+	fi, err := os.Stat(dir)
+	if err != nil {
+		return nil, err
+	}
+	st, ok := fi.Sys().(*syscall.Stat_t)
+	if !ok {
+		return nil, nil
+	}
+
+	dumpable := types.DumpableUser
+	if uint64(st.Uid) != euid {
+		suidDumpable, err := readIntFile(suidDumpablePath)
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if suidDumpable == int(types.DumpableRoot) {
+			return nil, nil
+		}
+		dumpable = types.DumpableDisabled
+	}
+	return &dumpable, nil
+}
+
+// inInitialUserNamespace reports whether /proc/[pid]/uid_map contains the
+// identity mapping of all UIDs, which is only the case in the initial user
+// namespace. Kernels without user namespaces do not provide the file.
+func inInitialUserNamespace(uidMapPath string) (bool, error) {
+	content, err := os.ReadFile(uidMapPath)
+	if errors.Is(err, os.ErrNotExist) {
+		return true, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return strings.Join(strings.Fields(string(content)), " ") == "0 0 4294967295", nil
+}
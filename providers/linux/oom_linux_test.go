@@ -0,0 +1,113 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package linux
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/go-sysinfo/types"
+)
+
+func TestProcessOOM(t *testing.T) {
+	proc, err := newLinuxSystem("testdata/fedora40").Process(33925)
+	require.NoError(t, err)
+
+	oom, err := proc.(types.OOM).OOM()
+	require.NoError(t, err)
+	assert.Equal(t, &types.OOMInfo{Score: 667, ScoreAdj: 0}, oom)
+
+	coreDump, err := proc.(types.CoreDump).CoreDump()
+	require.NoError(t, err)
+	assert.EqualValues(t, 0x33, coreDump.Filter)
+}
+
+func TestKernelThreadCoreDump(t *testing.T) {
+	proc, err := newLinuxSystem("testdata/fedora40").Process(2)
+	require.NoError(t, err)
+
+	coreDump, err := proc.(types.CoreDump).CoreDump()
+	require.NoError(t, err)
+	assert.Equal(t, &types.CoreDumpInfo{}, coreDump)
+}
+
+func TestSelfCoreDump(t *testing.T) {
+	proc, err := newLinuxSystem("").Self()
+	require.NoError(t, err)
+
+	coreDump, err := proc.(types.CoreDump).CoreDump()
+	require.NoError(t, err)
+	require.NotNil(t, coreDump.Dumpable)
+	assert.Equal(t, types.DumpableUser, *coreDump.Dumpable)
+}
+
+func TestDumpableFromOwner(t *testing.T) {
+	dir := t.TempDir()
+	owner := uint64(os.Getuid())
+	suidDumpable := filepath.Join(dir, "suid_dumpable")
+	writeFile := func(name, content string) {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o644))
+	}
+
+	// Processes running as root are always owned by root.
+	dumpable, err := dumpableFromOwner(dir, 0, suidDumpable)
+	require.NoError(t, err)
+	assert.Nil(t, dumpable)
+
+	writeFile("uid_map", "         0          0 4294967295\n")
+	writeFile("suid_dumpable", "0\n")
+	dumpable, err = dumpableFromOwner(dir, owner+1, suidDumpable)
+	require.NoError(t, err)
+	require.NotNil(t, dumpable)
+	assert.Equal(t, types.DumpableDisabled, *dumpable)
+
+	if owner != 0 {
+		dumpable, err = dumpableFromOwner(dir, owner, suidDumpable)
+		require.NoError(t, err)
+		require.NotNil(t, dumpable)
+		assert.Equal(t, types.DumpableUser, *dumpable)
+	}
+
+	// Not dumpable by the user, but possibly by root.
+	writeFile("suid_dumpable", "2\n")
+	dumpable, err = dumpableFromOwner(dir, owner+1, suidDumpable)
+	require.NoError(t, err)
+	assert.Nil(t, dumpable)
+
+	// In a rootless container the namespace root is mapped to the user.
+	writeFile("uid_map", "         0       1000          1\n")
+	dumpable, err = dumpableFromOwner(dir, owner+1, suidDumpable)
+	require.NoError(t, err)
+	assert.Nil(t, dumpable)
+}
+
+func TestTopOOMScores(t *testing.T) {
+	procs, err := newLinuxSystem("testdata/fedora40").Processes()
+	require.NoError(t, err)
+
+	top := types.TopOOMScores(procs, 3)
+	require.Len(t, top, 3)
+	assert.Equal(t, 33925, top[0].PID)
+	assert.Equal(t, 33932, top[1].PID)
+	assert.Equal(t, 300, top[1].OOM.ScoreAdj)
+	assert.Equal(t, 33940, top[2].PID)
+}
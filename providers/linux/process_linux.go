@@ -19,10 +19,10 @@ package linux
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 	"os"
 	"strconv"
-	"strings"
 	"time"
 
 	"github.com/prometheus/procfs"
@@ -41,7 +41,7 @@ func (s linuxSystem) Processes() ([]types.Process, error) {
 
 	processes := make([]types.Process, 0, len(procs))
 	for _, proc := range procs {
-		processes = append(processes, &process{Proc: proc, fs: s.procFS})
+		processes = append(processes, &process{Proc: proc, fs: s.procFS, redactor: s.redactor})
 	}
 	return processes, nil
 }
@@ -53,7 +53,7 @@ func (s linuxSystem) Process(pid int) (types.Process, error) {
 		return nil, fmt.Errorf("error fetching process: %w", err)
 	}
 
-	return &process{Proc: proc, fs: s.procFS}, nil
+	return &process{Proc: proc, fs: s.procFS, redactor: s.redactor}, nil
 }
 
 // Self returns process info for the caller's own PID
@@ -63,13 +63,14 @@ func (s linuxSystem) Self() (types.Process, error) {
 		return nil, fmt.Errorf("error fetching self process info: %w", err)
 	}
 
-	return &process{Proc: proc, fs: s.procFS}, nil
+	return &process{Proc: proc, fs: s.procFS, redactor: s.redactor}, nil
 }
 
 type process struct {
 	procfs.Proc
-	fs   procFS
-	info *types.ProcessInfo
+	fs       procFS
+	info     *types.ProcessInfo
+	redactor *types.Redactor
 }
 
 // PID returns the PID of the process
@@ -77,19 +78,108 @@ func (p *process) PID() int {
 	return p.Proc.PID
 }
 
-// Parent returns the parent process
+// Parent returns the parent process. It returns an error wrapping
+// types.ErrParentPIDReused if the parent PID now belongs to a process that
+// started after this one, which means that the original parent has exited.
 func (p *process) Parent() (types.Process, error) {
-	info, err := p.Info()
+	stat, err := p.Stat()
 	if err != nil {
-		return nil, fmt.Errorf("error fetching process info: %w", err)
+		return nil, fmt.Errorf("error fetching process stats: %w", err)
 	}
 
-	proc, err := p.fs.Proc(info.PPID)
+	proc, err := p.fs.Proc(stat.PPID)
 	if err != nil {
 		return nil, fmt.Errorf("error fetching data for parent process: %w", err)
 	}
 
-	return &process{Proc: proc, fs: p.fs}, nil
+	parentStat, err := proc.Stat()
+	if err != nil {
+		return nil, fmt.Errorf("error fetching parent process stats: %w", err)
+	}
+	if parentStat.Starttime > stat.Starttime {
+		return nil, fmt.Errorf("process %d started after its child %d: %w", stat.PPID, p.PID(), types.ErrParentPIDReused)
+	}
+
+	return &process{Proc: proc, fs: p.fs, redactor: p.redactor}, nil
+}
+
+// EntityKey returns the PID, start time and boot ID of the process.
+func (p *process) EntityKey() (types.ProcessEntityKey, error) {
+	lineage, err := p.Lineage()
+	if err != nil {
+		return types.ProcessEntityKey{}, err
+	}
+	return lineage.Key, nil
+}
+
+// Lineage returns the entity key and the parent PID of the process from a
+// single read of /proc/[pid]/stat.
+func (p *process) Lineage() (types.ProcessLineage, error) {
+	stat, err := p.Stat()
+	if err != nil {
+		return types.ProcessLineage{}, fmt.Errorf("error fetching process stats: %w", err)
+	}
+
+	bootID, err := p.fs.readBootID()
+	if err != nil {
+		return types.ProcessLineage{}, err
+	}
+
+	return types.ProcessLineage{
+		Key: types.ProcessEntityKey{
+			PID:        p.PID(),
+			StartTicks: stat.Starttime,
+			BootID:     bootID,
+		},
+		PPID: stat.PPID,
+	}, nil
+}
+
+// Children returns the direct child processes. It reads the children of all
+// threads from /proc/[pid]/task/[tid]/children and scans the parent PIDs of
+// all processes when the kernel does not provide these files (they require
+// CONFIG_PROC_CHILDREN). Children that exit while they are read are omitted.
+func (p *process) Children() ([]types.Process, error) {
+	pids, err := readChildPIDs(p.path("task"))
+	if errors.Is(err, os.ErrNotExist) {
+		// Only scan when the children files are unsupported, not when the
+		// process itself has exited.
+		if _, err := os.Stat(p.path()); err != nil {
+			return nil, fmt.Errorf("error reading children: %w", err)
+		}
+		return p.scanChildren()
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error reading children: %w", err)
+	}
+
+	children := make([]types.Process, 0, len(pids))
+	for _, pid := range pids {
+		proc, err := p.fs.Proc(pid)
+		if err != nil {
+			continue
+		}
+		children = append(children, &process{Proc: proc, fs: p.fs, redactor: p.redactor})
+	}
+	return children, nil
+}
+
+// scanChildren returns the processes whose parent PID is the PID of p.
+func (p *process) scanChildren() ([]types.Process, error) {
+	procs, err := p.fs.AllProcs()
+	if err != nil {
+		return nil, fmt.Errorf("error fetching all processes: %w", err)
+	}
+
+	var children []types.Process
+	for _, proc := range procs {
+		stat, err := proc.Stat()
+		if err != nil || stat.PPID != p.PID() || proc.PID == p.PID() {
+			continue
+		}
+		children = append(children, &process{Proc: proc, fs: p.fs, redactor: p.redactor})
+	}
+	return children, nil
 }
 
 func (p *process) path(pa ...string) string {
@@ -117,7 +207,7 @@ func (p *process) Info() (types.ProcessInfo, error) {
 		return types.ProcessInfo{}, fmt.Errorf("error fetching process stats: %w", err)
 	}
 
-	exe, err := p.Executable()
+	exe, err := p.Proc.Executable()
 	if err != nil {
 		return types.ProcessInfo{}, fmt.Errorf("error fetching process executable info: %w", err)
 	}
@@ -143,24 +233,40 @@ func (p *process) Info() (types.ProcessInfo, error) {
 		PPID:      stat.PPID,
 		CWD:       cwd,
 		Exe:       exe,
-		Args:      args,
+		Args:      p.redactor.Args(args),
 		StartTime: bootTime.Add(ticksToDuration(stat.Starttime)),
 	}
 
 	return *p.info, nil
 }
 
-// Memory returns memory stats for the process
+// Memory returns memory stats for the process. The Metrics contain the
+// values of SmapsMetrics if smaps can be read, which requires ptrace access
+// to the process, and are nil otherwise.
 func (p *process) Memory() (types.MemoryInfo, error) {
 	stat, err := p.Stat()
 	if err != nil {
 		return types.MemoryInfo{}, err
 	}
 
-	return types.MemoryInfo{
+	info := types.MemoryInfo{
 		Resident: uint64(stat.ResidentMemory()),
 		Virtual:  uint64(stat.VirtualMemory()),
-	}, nil
+	}
+	if metrics, err := p.SmapsMetrics(); err == nil {
+		info.Metrics = metrics
+	}
+	return info, nil
+}
+
+// SmapsMetrics returns the proportional, unique and swapped memory of the
+// process in bytes. Reading smaps requires ptrace access to the process.
+func (p *process) SmapsMetrics() (map[string]uint64, error) {
+	metrics, err := readSmapsMetrics(p.path("smaps_rollup"), p.path("smaps"))
+	if err != nil {
+		return nil, fmt.Errorf("error reading smaps: %w", err)
+	}
+	return metrics, nil
 }
 
 // CPUTime returns CPU usage time for the process
@@ -210,7 +316,22 @@ func (p *process) Environment() (map[string]string, error) {
 		env[key] = string(parts[1])
 	}
 
-	return env, nil
+	return p.redactor.Environment(env), nil
+}
+
+// Status returns the parsed contents of /proc/[pid]/status.
+func (p *process) Status() (*types.ProcessStatus, error) {
+	content, err := os.ReadFile(p.path("status"))
+	if err != nil {
+		return nil, err
+	}
+
+	status, err := parseProcStatus(content)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing status data: %w", err)
+	}
+
+	return status, nil
 }
 
 // Seccomp returns seccomp info for the process
@@ -233,39 +354,15 @@ func (p *process) Capabilities() (*types.CapabilityInfo, error) {
 	return readCapabilities(content)
 }
 
-// User returns user info for the process
+// User returns user info for the process. Only the Uid and Gid lines of
+// /proc/[pid]/status are parsed so that unrelated fields cannot cause an error.
 func (p *process) User() (types.UserInfo, error) {
 	content, err := os.ReadFile(p.path("status"))
 	if err != nil {
 		return types.UserInfo{}, err
 	}
 
-	var user types.UserInfo
-	err = parseKeyValue(content, ':', func(key, value []byte) error {
-		// See proc(5) for the format of /proc/[pid]/status
-		switch string(key) {
-		case "Uid":
-			ids := strings.Split(string(value), "\t")
-			if len(ids) >= 3 {
-				user.UID = ids[0]
-				user.EUID = ids[1]
-				user.SUID = ids[2]
-			}
-		case "Gid":
-			ids := strings.Split(string(value), "\t")
-			if len(ids) >= 3 {
-				user.GID = ids[0]
-				user.EGID = ids[1]
-				user.SGID = ids[2]
-			}
-		}
-		return nil
-	})
-	if err != nil {
-		return user, fmt.Errorf("error partsing key-values in user data: %w", err)
-	}
-
-	return user, nil
+	return parseStatusUser(content)
 }
 
 // NetworkStats reports network stats for an individual PID.
@@ -291,6 +388,221 @@ func (p *process) NetworkCounters() (*types.NetworkCountersInfo, error) {
 	return &types.NetworkCountersInfo{SNMP: snmp, Netstat: netstat}, nil
 }
 
+// Routes reports the routing tables of the network namespace of the process.
+func (p *process) Routes() ([]types.Route, error) {
+	return readRoutes(p.path("net/route"), p.path("net/ipv6_route"))
+}
+
+// NUMAMaps returns the memory of the process summarized per NUMA node.
+func (p *process) NUMAMaps() ([]types.NUMANodeMemory, error) {
+	content, err := os.ReadFile(p.path("numa_maps"))
+	if err != nil {
+		return nil, err
+	}
+
+	return parseNUMAMaps(content)
+}
+
+// IOCounters returns the I/O accounting counters of the process.
+func (p *process) IOCounters() (*types.IOCountersInfo, error) {
+	return readIOCounters(p.path("io"), p.PID())
+}
+
+// MemoryMaps returns the memory regions mapped by the process.
+func (p *process) MemoryMaps() ([]types.MemoryMap, error) {
+	content, err := os.ReadFile(p.path("maps"))
+	if err != nil {
+		return nil, err
+	}
+
+	return parseMemoryMaps(content)
+}
+
+// Threads returns the threads of the process. Threads that exit while they
+// are read are omitted.
+func (p *process) Threads() ([]types.ThreadInfo, error) {
+	threads, err := p.fs.AllThreads(p.PID())
+	if err != nil {
+		return nil, fmt.Errorf("error listing threads: %w", err)
+	}
+
+	infos := make([]types.ThreadInfo, 0, len(threads))
+	for _, thread := range threads {
+		info, err := readThread(thread, p.path("task", strconv.Itoa(thread.PID), "status"))
+		if err != nil {
+			if isExited(err) {
+				continue
+			}
+			return nil, fmt.Errorf("error reading thread %d: %w", thread.PID, err)
+		}
+		infos = append(infos, info)
+	}
+
+	return infos, nil
+}
+
+// Namespaces returns the inode numbers of the namespaces of the process.
+func (p *process) Namespaces() (types.NamespaceInodes, error) {
+	return readNamespaces(p.Proc)
+}
+
+// IsolatedNamespaces returns the names of the namespaces of the process that
+// differ from those of PID 1.
+func (p *process) IsolatedNamespaces() ([]string, error) {
+	ns, err := p.Namespaces()
+	if err != nil {
+		return nil, err
+	}
+
+	initProc, err := p.fs.Proc(1)
+	if err != nil {
+		return nil, fmt.Errorf("error fetching init process: %w", err)
+	}
+	initNS, err := readNamespaces(initProc)
+	if err != nil {
+		return nil, fmt.Errorf("error reading init process namespaces: %w", err)
+	}
+
+	return ns.Differs(initNS), nil
+}
+
+func readNamespaces(proc procfs.Proc) (types.NamespaceInodes, error) {
+	namespaces, err := proc.Namespaces()
+	if err != nil {
+		return nil, fmt.Errorf("error reading namespaces: %w", err)
+	}
+
+	inodes := make(types.NamespaceInodes, len(namespaces))
+	for name, ns := range namespaces {
+		inodes[name] = uint64(ns.Inode)
+	}
+
+	return inodes, nil
+}
+
+// ResourceLimits returns the resource limits of the process.
+func (p *process) ResourceLimits() (*types.ResourceLimitsInfo, error) {
+	content, err := os.ReadFile(p.path("limits"))
+	if err != nil {
+		return nil, err
+	}
+
+	return parseResourceLimits(content)
+}
+
+// Scheduling returns the state and scheduling attributes of the process.
+// The I/O priority is only queried when the process is read from the /proc
+// of the running system because ioprio_get(2) does not support hostfs.
+func (p *process) Scheduling() (*types.SchedulingInfo, error) {
+	stat, err := p.Stat()
+	if err != nil {
+		return nil, err
+	}
+	status, err := p.Status()
+	if err != nil {
+		return nil, err
+	}
+
+	info := &types.SchedulingInfo{
+		State:       stat.State,
+		Priority:    stat.Priority,
+		Nice:        stat.Nice,
+		NumThreads:  stat.NumThreads,
+		LastCPU:     int(stat.Processor),
+		RTPriority:  int(stat.RTPriority),
+		Policy:      schedulingPolicyName(stat.Policy),
+		CPUAffinity: status.CPUsAllowed,
+	}
+
+	if p.fs.mountPoint == procfs.DefaultMountPoint {
+		// The I/O priority is left nil if the process is not accessible
+		// (EPERM) or exited in the meantime (ESRCH).
+		info.IOPriority, _ = getIOPriority(p.PID())
+	}
+
+	content, err := os.ReadFile(p.path("autogroup"))
+	switch {
+	case err == nil:
+		if info.Autogroup, err = parseAutogroup(content); err != nil {
+			return nil, err
+		}
+	case !errors.Is(err, os.ErrNotExist):
+		return nil, err
+	}
+
+	return info, nil
+}
+
+// OOM returns the out-of-memory killer score of the process.
+func (p *process) OOM() (*types.OOMInfo, error) {
+	score, err := readIntFile(p.path("oom_score"))
+	if err != nil {
+		return nil, fmt.Errorf("error reading OOM score: %w", err)
+	}
+	scoreAdj, err := readIntFile(p.path("oom_score_adj"))
+	if err != nil {
+		return nil, fmt.Errorf("error reading OOM score adjustment: %w", err)
+	}
+	return &types.OOMInfo{Score: score, ScoreAdj: scoreAdj}, nil
+}
+
+// CoreDump returns the core dump filter and dumpable setting of the process.
+// The dumpable setting is read with prctl for the calling process and derived
+// from the owner of /proc/[pid] for other processes. Kernel threads have no
+// memory map and report a zero filter and no dumpable setting.
+func (p *process) CoreDump() (*types.CoreDumpInfo, error) {
+	filter, hasMM, err := readCoredumpFilter(p.path("coredump_filter"))
+	if err != nil {
+		return nil, fmt.Errorf("error reading coredump filter: %w", err)
+	}
+	info := &types.CoreDumpInfo{Filter: filter}
+	if !hasMM {
+		return info, nil
+	}
+
+	if p.fs.mountPoint == procfs.DefaultMountPoint && p.PID() == os.Getpid() {
+		dumpable, err := selfDumpable()
+		if err != nil {
+			return nil, err
+		}
+		info.Dumpable = &dumpable
+		return info, nil
+	}
+
+	user, err := p.User()
+	if err != nil {
+		return nil, fmt.Errorf("error fetching process user: %w", err)
+	}
+	euid, err := strconv.ParseUint(user.EUID, 10, 32)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing effective UID %q: %w", user.EUID, err)
+	}
+	if info.Dumpable, err = dumpableFromOwner(p.path(), euid, p.fs.path("sys/fs/suid_dumpable")); err != nil {
+		return nil, fmt.Errorf("error reading dumpable setting: %w", err)
+	}
+	return info, nil
+}
+
+// Executable returns information about the executable of the process.
+func (p *process) Executable() (*types.ExecutableInfo, error) {
+	return readExecutable(p.path("exe"))
+}
+
+// GoBuild returns the build information of the executable of a Go process.
+func (p *process) GoBuild() (*types.GoBuildInfo, error) {
+	return readGoBuildInfo(p.path("exe"))
+}
+
+// Container returns the container and Kubernetes pod of the process.
+func (p *process) Container() (*types.ContainerInfo, error) {
+	data, err := os.ReadFile(p.path("cgroup"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read process cgroups: %w", err)
+	}
+
+	return parseCgroupContainer(data), nil
+}
+
 func ticksToDuration(ticks uint64) time.Duration {
 	seconds := float64(ticks) / float64(userHz) * float64(time.Second)
 	return time.Duration(int64(seconds))
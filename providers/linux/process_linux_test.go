@@ -50,3 +50,24 @@ func TestProcessNetstat(t *testing.T) {
 	assert.NotEmpty(t, stats.SNMP.TCP, "TCP")
 	assert.NotEmpty(t, stats.SNMP.UDP, "UDP")
 }
+
+func TestProcessEnvironmentRedaction(t *testing.T) {
+	s := newLinuxSystem("testdata/fedora40")
+	s.redactor = types.NewRedactor(types.DefaultRedactionConfig())
+	proc, err := s.Process(33925)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	env, err := proc.(types.Environment).Environment()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	assert.Equal(t, map[string]string{
+		"HOME":         "/root",
+		"DB_PASSWORD":  "[REDACTED:key:*PASSWORD*]",
+		"GITHUB_TOKEN": "[REDACTED:key:*TOKEN*]",
+		"DATABASE_URL": "postgres://app:[REDACTED:url-userinfo]@db:5432/app",
+	}, env)
+}
@@ -0,0 +1,217 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package linux
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"math/bits"
+	"net/netip"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/elastic/go-sysinfo/types"
+)
+
+// routeFlagNames is a mapping of RTF_* flag bit positions to names.
+// See include/uapi/linux/route.h and include/uapi/linux/ipv6_route.h.
+var routeFlagNames = map[int]string{
+	0:  "up",
+	1:  "gateway",
+	2:  "host",
+	3:  "reinstate",
+	4:  "dynamic",
+	5:  "modified",
+	6:  "mtu",
+	7:  "window",
+	8:  "irtt",
+	9:  "reject",
+	16: "default",
+	17: "allonlink",
+	18: "addrconf",
+	19: "prefix_rt",
+	20: "anycast",
+	21: "nonexthop",
+	22: "expires",
+	23: "routeinfo",
+	24: "cache",
+	25: "flow",
+	26: "policy",
+	31: "local",
+}
+
+func routeFlagName(num int) string {
+	name, found := routeFlagNames[num]
+	if found {
+		return name
+	}
+
+	return strconv.Itoa(num)
+}
+
+// readRoutes reads the IPv4 and IPv6 routing tables from the given route and
+// ipv6_route files. A missing ipv6_route file (IPv6 disabled) is not an error.
+func readRoutes(routeFile, ipv6RouteFile string) ([]types.Route, error) {
+	content, err := os.ReadFile(routeFile)
+	if err != nil {
+		return nil, fmt.Errorf("error reading route file: %w", err)
+	}
+	routes, err := parseIPv4Routes(content)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing route file: %w", err)
+	}
+
+	content, err = os.ReadFile(ipv6RouteFile)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return routes, nil
+		}
+		return nil, fmt.Errorf("error reading ipv6_route file: %w", err)
+	}
+	routes6, err := parseIPv6Routes(content)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing ipv6_route file: %w", err)
+	}
+
+	return append(routes, routes6...), nil
+}
+
+// parseIPv4Routes parses the contents of /proc/net/route. Addresses are
+// printed as hex encoded 32-bit integers in host byte order.
+func parseIPv4Routes(content []byte) ([]types.Route, error) {
+	var routes []types.Route
+
+	sc := bufio.NewScanner(bytes.NewReader(content))
+	for n := 0; sc.Scan(); n++ {
+		fields := strings.Fields(sc.Text())
+		// Skip the header line.
+		if n == 0 || len(fields) == 0 {
+			continue
+		}
+		if len(fields) < 8 {
+			return nil, fmt.Errorf("unexpected line format %q", sc.Text())
+		}
+
+		dst, err := parseHostOrderIPv4(fields[1])
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse destination: %w", err)
+		}
+		gw, err := parseHostOrderIPv4(fields[2])
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse gateway: %w", err)
+		}
+		mask, err := strconv.ParseUint(fields[7], 16, 32)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse mask: %w", err)
+		}
+		metric, err := strconv.ParseUint(fields[6], 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse metric: %w", err)
+		}
+		flags, err := decodeBitMap(fields[3], routeFlagName)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse flags: %w", err)
+		}
+
+		routes = append(routes, types.Route{
+			Family:      types.RouteFamilyIPv4,
+			Destination: netip.PrefixFrom(dst, bits.OnesCount32(uint32(mask))),
+			Gateway:     gw,
+			Interface:   fields[0],
+			Metric:      uint32(metric),
+			Flags:       flags,
+		})
+	}
+
+	return routes, sc.Err()
+}
+
+// parseIPv6Routes parses the contents of /proc/net/ipv6_route. Addresses are
+// printed as 32 hex characters in network byte order.
+func parseIPv6Routes(content []byte) ([]types.Route, error) {
+	var routes []types.Route
+
+	sc := bufio.NewScanner(bytes.NewReader(content))
+	for sc.Scan() {
+		fields := strings.Fields(sc.Text())
+		if len(fields) == 0 {
+			continue
+		}
+		if len(fields) < 10 {
+			return nil, fmt.Errorf("unexpected line format %q", sc.Text())
+		}
+
+		dst, err := parseIPv6(fields[0])
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse destination: %w", err)
+		}
+		prefixLen, err := strconv.ParseUint(fields[1], 16, 8)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse destination prefix length: %w", err)
+		}
+		gw, err := parseIPv6(fields[4])
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse next hop: %w", err)
+		}
+		metric, err := strconv.ParseUint(fields[5], 16, 32)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse metric: %w", err)
+		}
+		flags, err := decodeBitMap(fields[8], routeFlagName)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse flags: %w", err)
+		}
+
+		routes = append(routes, types.Route{
+			Family:      types.RouteFamilyIPv6,
+			Destination: netip.PrefixFrom(dst, int(prefixLen)),
+			Gateway:     gw,
+			Interface:   fields[9],
+			Metric:      uint32(metric),
+			Flags:       flags,
+		})
+	}
+
+	return routes, sc.Err()
+}
+
+func parseHostOrderIPv4(s string) (netip.Addr, error) {
+	v, err := strconv.ParseUint(s, 16, 32)
+	if err != nil {
+		return netip.Addr{}, err
+	}
+
+	var b [4]byte
+	binary.NativeEndian.PutUint32(b[:], uint32(v))
+	return netip.AddrFrom4(b), nil
+}
+
+func parseIPv6(s string) (netip.Addr, error) {
+	var b [16]byte
+	if len(s) != hex.EncodedLen(len(b)) {
+		return netip.Addr{}, fmt.Errorf("invalid IPv6 address %q", s)
+	}
+	if _, err := hex.Decode(b[:], []byte(s)); err != nil {
+		return netip.Addr{}, err
+	}
+	return netip.AddrFrom16(b), nil
+}
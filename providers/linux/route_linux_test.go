@@ -0,0 +1,94 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package linux
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/go-sysinfo/types"
+)
+
+func TestHostRoutes(t *testing.T) {
+	host, err := newLinuxSystem("testdata/fedora30").Host()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	routes, err := host.(types.Routes).Routes()
+	require.NoError(t, err)
+	require.Len(t, routes, 11)
+
+	assert.Equal(t, types.Route{
+		Family:      types.RouteFamilyIPv4,
+		Destination: netip.MustParsePrefix("10.0.2.0/24"),
+		Gateway:     netip.MustParseAddr("0.0.0.0"),
+		Interface:   "enp0s3",
+		Metric:      100,
+		Flags:       []string{"up"},
+	}, routes[2])
+
+	assert.Equal(t, types.Route{
+		Family:      types.RouteFamilyIPv6,
+		Destination: netip.MustParsePrefix("::/0"),
+		Gateway:     netip.MustParseAddr("fe80::2"),
+		Interface:   "enp0s3",
+		Metric:      100,
+		Flags:       []string{"up", "gateway", "default", "addrconf", "expires"},
+	}, routes[7])
+
+	gw, ok := types.DefaultGateway(routes, types.RouteFamilyIPv4)
+	if assert.True(t, ok) {
+		assert.Equal(t, "10.0.2.2", gw.Gateway.String())
+		assert.Equal(t, "enp0s3", gw.Interface)
+	}
+
+	gw, ok = types.DefaultGateway(routes, types.RouteFamilyIPv6)
+	if assert.True(t, ok) {
+		assert.Equal(t, "fe80::2", gw.Gateway.String())
+	}
+}
+
+func TestProcessRoutes(t *testing.T) {
+	proc, err := newLinuxSystem("testdata/fedora40").Process(33925)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// There is no ipv6_route file for this process.
+	routes, err := proc.(types.Routes).Routes()
+	require.NoError(t, err)
+	require.Len(t, routes, 2)
+
+	gw, ok := types.DefaultGateway(routes, types.RouteFamilyIPv4)
+	if assert.True(t, ok) {
+		assert.Equal(t, "172.17.0.1", gw.Gateway.String())
+	}
+	assert.Equal(t, "172.17.0.0/16", routes[1].Destination.String())
+
+	_, ok = types.DefaultGateway(routes, types.RouteFamilyIPv6)
+	assert.False(t, ok)
+}
+
+func TestParseIPv6RoutesInvalid(t *testing.T) {
+	_, err := parseIPv6Routes([]byte("0000 00 0000 00 0000 00000000 00000001 00000000 00000001 lo\n"))
+	assert.Error(t, err)
+}
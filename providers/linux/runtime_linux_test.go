@@ -0,0 +1,73 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package linux
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"runtime/debug"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/go-sysinfo/types"
+)
+
+func TestDetectRuntimeSelf(t *testing.T) {
+	proc, err := newLinuxSystem("").Self()
+	require.NoError(t, err)
+
+	rt, err := types.DetectRuntime(proc)
+	require.NoError(t, err)
+
+	build, ok := debug.ReadBuildInfo()
+	require.True(t, ok)
+	assert.Equal(t, &types.RuntimeInfo{
+		Runtime:     types.RuntimeGo,
+		Version:     runtime.Version(),
+		Application: build.Path,
+	}, rt)
+}
+
+func TestDetectRuntimePerl(t *testing.T) {
+	perl, err := exec.LookPath("perl")
+	if err != nil {
+		t.Skip("perl not found:", err)
+	}
+	script := filepath.Join(t.TempDir(), "worker.pl")
+	require.NoError(t, os.WriteFile(script, []byte("sleep 10;\n"), 0o644))
+
+	cmd := exec.Command(perl, "-w", script)
+	require.NoError(t, cmd.Start())
+	defer func() {
+		_ = cmd.Process.Kill()
+		_ = cmd.Wait()
+	}()
+
+	proc, err := newLinuxSystem("").Process(cmd.Process.Pid)
+	require.NoError(t, err)
+
+	rt, err := types.DetectRuntime(proc)
+	require.NoError(t, err)
+	require.NotNil(t, rt)
+	assert.Equal(t, types.RuntimePerl, rt.Runtime)
+	assert.Equal(t, script, rt.Application)
+}
@@ -0,0 +1,81 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package linux
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"golang.org/x/sys/unix"
+
+	"github.com/elastic/go-sysinfo/types"
+)
+
+// ioprio_get(2) constants from include/uapi/linux/ioprio.h.
+const (
+	ioprioWhoProcess = 1
+	ioprioClassShift = 13
+	ioprioPrioMask   = (1 << ioprioClassShift) - 1
+)
+
+// ioPriorityClassNames is mapping of IOPRIO_CLASS_* values to names.
+var ioPriorityClassNames = map[int]string{
+	0: types.IOPriorityClassNone,
+	1: types.IOPriorityClassRealtime,
+	2: types.IOPriorityClassBestEffort,
+	3: types.IOPriorityClassIdle,
+}
+
+// getIOPriority returns the I/O priority of a process using ioprio_get(2).
+func getIOPriority(pid int) (*types.IOPriority, error) {
+	r, _, errno := unix.Syscall(unix.SYS_IOPRIO_GET, ioprioWhoProcess, uintptr(pid), 0)
+	if errno != 0 {
+		return nil, fmt.Errorf("ioprio_get failed: %w", errno)
+	}
+	return decodeIOPriority(int(r)), nil
+}
+
+func decodeIOPriority(ioprio int) *types.IOPriority {
+	class := ioprio >> ioprioClassShift
+	name, found := ioPriorityClassNames[class]
+	if !found {
+		name = strconv.Itoa(class)
+	}
+	return &types.IOPriority{Class: name, Level: ioprio & ioprioPrioMask}
+}
+
+// parseAutogroup parses the contents of /proc/[pid]/autogroup which has the
+// format "/autogroup-<id> nice <nice>".
+func parseAutogroup(content []byte) (*types.Autogroup, error) {
+	fields := strings.Fields(string(content))
+	if len(fields) != 3 || fields[1] != "nice" {
+		return nil, fmt.Errorf("unexpected autogroup format %q", content)
+	}
+
+	id, err := strconv.Atoi(strings.TrimPrefix(fields[0], "/autogroup-"))
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse autogroup ID: %w", err)
+	}
+	nice, err := strconv.Atoi(fields[2])
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse autogroup nice value: %w", err)
+	}
+
+	return &types.Autogroup{ID: id, Nice: nice}, nil
+}
@@ -0,0 +1,82 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package linux
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/sys/unix"
+
+	"github.com/elastic/go-sysinfo/types"
+)
+
+func TestProcessScheduling(t *testing.T) {
+	proc, err := newLinuxSystem("testdata/fedora40").Process(33925)
+	require.NoError(t, err)
+
+	sched, err := proc.(types.Scheduling).Scheduling()
+	require.NoError(t, err)
+
+	assert.Equal(t, &types.SchedulingInfo{
+		State:       "S",
+		Priority:    20,
+		NumThreads:  2,
+		LastCPU:     3,
+		Policy:      "normal",
+		CPUAffinity: []int{2, 3, 4, 5},
+		Autogroup:   &types.Autogroup{ID: 1187},
+	}, sched)
+}
+
+func TestProcessSchedulingSelf(t *testing.T) {
+	proc, err := newLinuxSystem("").Self()
+	require.NoError(t, err)
+
+	sched, err := proc.(types.Scheduling).Scheduling()
+	require.NoError(t, err)
+
+	var affinity unix.CPUSet
+	require.NoError(t, unix.SchedGetaffinity(0, &affinity))
+	assert.Len(t, sched.CPUAffinity, affinity.Count())
+	assert.Equal(t, 20+sched.Nice, sched.Priority)
+	if assert.NotNil(t, sched.IOPriority) {
+		assert.Contains(t, []string{
+			types.IOPriorityClassNone,
+			types.IOPriorityClassRealtime,
+			types.IOPriorityClassBestEffort,
+			types.IOPriorityClassIdle,
+		}, sched.IOPriority.Class)
+	}
+}
+
+func TestDecodeIOPriority(t *testing.T) {
+	assert.Equal(t, &types.IOPriority{Class: types.IOPriorityClassNone}, decodeIOPriority(0))
+	assert.Equal(t, &types.IOPriority{Class: types.IOPriorityClassBestEffort, Level: 7}, decodeIOPriority(2<<13|7))
+	assert.Equal(t, &types.IOPriority{Class: types.IOPriorityClassIdle}, decodeIOPriority(3<<13))
+}
+
+func TestParseAutogroup(t *testing.T) {
+	ag, err := parseAutogroup([]byte("/autogroup-122 nice -5\n"))
+	require.NoError(t, err)
+	assert.Equal(t, &types.Autogroup{ID: 122, Nice: -5}, ag)
+
+	_, err = parseAutogroup([]byte("/autogroup-122\n"))
+	assert.Error(t, err)
+}
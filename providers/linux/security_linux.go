@@ -0,0 +1,242 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package linux
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/elastic/go-sysinfo/types"
+)
+
+const (
+	sysSecurityPath        = "sys/kernel/security"
+	sysSELinuxPath         = "sys/fs/selinux"
+	sysAppArmorEnabledPath = "sys/module/apparmor/parameters/enabled"
+	sysVulnerabilitiesPath = "sys/devices/system/cpu/vulnerabilities"
+	selinuxConfigPath      = "etc/selinux/config"
+
+	// efiSecureBootVar is the SecureBoot variable of the EFI global variable GUID.
+	efiSecureBootVar = "sys/firmware/efi/efivars/SecureBoot-8be4df61-93ca-11d2-aa0d-00e098032b8c"
+)
+
+// taintNames is a mapping of kernel taint bit positions to names.
+// See Documentation/admin-guide/tainted-kernels.rst.
+var taintNames = map[int]string{
+	0:  "proprietary_module",
+	1:  "forced_module",
+	2:  "cpu_out_of_spec",
+	3:  "forced_rmmod",
+	4:  "machine_check",
+	5:  "bad_page",
+	6:  "user",
+	7:  "die",
+	8:  "overridden_acpi_table",
+	9:  "warn",
+	10: "staging_module",
+	11: "firmware_workaround",
+	12: "oot_module",
+	13: "unsigned_module",
+	14: "soft_lockup",
+	15: "livepatch",
+	16: "aux",
+	17: "randstruct",
+	18: "test",
+}
+
+func taintName(num int) string {
+	name, found := taintNames[num]
+	if found {
+		return name
+	}
+
+	return strconv.Itoa(num)
+}
+
+// readOptional returns the trimmed contents of a file. The second return
+// value is false if the file does not exist or is not readable by the
+// current user.
+func readOptional(path string) (string, bool, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) || os.IsPermission(err) {
+			return "", false, nil
+		}
+		return "", false, err
+	}
+
+	return strings.TrimSpace(string(content)), true, nil
+}
+
+func readSecurityPosture(fs procFS) (*types.SecurityPostureInfo, error) {
+	var info types.SecurityPostureInfo
+
+	lsm, ok, err := readOptional(fs.rootPath(sysSecurityPath, "lsm"))
+	if err != nil {
+		return nil, err
+	}
+	if ok && lsm != "" {
+		info.LSMs = strings.Split(lsm, ",")
+	}
+
+	if info.SELinux, err = readSELinux(fs.rootPath(sysSELinuxPath), fs.rootPath(selinuxConfigPath)); err != nil {
+		return nil, fmt.Errorf("error reading SELinux status: %w", err)
+	}
+
+	if info.AppArmor, err = readAppArmor(fs.rootPath(sysAppArmorEnabledPath), fs.rootPath(sysSecurityPath, "apparmor", "profiles")); err != nil {
+		return nil, fmt.Errorf("error reading AppArmor status: %w", err)
+	}
+
+	lockdown, ok, err := readOptional(fs.rootPath(sysSecurityPath, "lockdown"))
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		info.Lockdown = selectedMode(lockdown)
+	}
+
+	tainted, ok, err := readOptional(fs.path("sys/kernel/tainted"))
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		if info.Tainted, err = strconv.ParseUint(tainted, 10, 64); err != nil {
+			return nil, fmt.Errorf("failed to parse tainted value %q: %w", tainted, err)
+		}
+		if info.TaintFlags, err = decodeBitMap(strconv.FormatUint(info.Tainted, 16), taintName); err != nil {
+			return nil, err
+		}
+	}
+
+	if info.SecureBoot, err = readSecureBoot(fs.rootPath(efiSecureBootVar)); err != nil {
+		return nil, fmt.Errorf("error reading Secure Boot state: %w", err)
+	}
+
+	if info.CPUVulnerabilities, err = readCPUVulnerabilities(fs.rootPath(sysVulnerabilitiesPath)); err != nil {
+		return nil, fmt.Errorf("error reading CPU vulnerabilities: %w", err)
+	}
+
+	return &info, nil
+}
+
+// readSELinux reads the SELinux mode from selinuxfs and the policy type from
+// the SELinux config file. It returns nil if selinuxfs is not mounted.
+func readSELinux(selinuxfs, configFile string) (*types.SELinuxInfo, error) {
+	enforce, ok, err := readOptional(filepath.Join(selinuxfs, "enforce"))
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	selinux := &types.SELinuxInfo{Mode: "permissive"}
+	if enforce == "1" {
+		selinux.Mode = "enforcing"
+	}
+
+	if v, ok, _ := readOptional(filepath.Join(selinuxfs, "policyvers")); ok {
+		selinux.PolicyVersion, _ = strconv.Atoi(v)
+	}
+	if v, ok, _ := readOptional(filepath.Join(selinuxfs, "mls")); ok {
+		selinux.MLS = v == "1"
+	}
+
+	if v, err := findValue(configFile, "=", "SELINUXTYPE="); err == nil {
+		selinux.PolicyType = v
+	}
+
+	return selinux, nil
+}
+
+// readAppArmor reads whether AppArmor is enabled and counts the loaded
+// profiles per mode. It returns nil if the AppArmor module is not loaded.
+func readAppArmor(enabledFile, profilesFile string) (*types.AppArmorInfo, error) {
+	enabled, ok, err := readOptional(enabledFile)
+	if err != nil || !ok {
+		return nil, err
+	}
+
+	apparmor := &types.AppArmorInfo{Enabled: enabled == "Y"}
+
+	profiles, ok, err := readOptional(profilesFile)
+	if err != nil || !ok {
+		return apparmor, err
+	}
+
+	// Each line has the format "<profile name> (<mode>)".
+	sc := bufio.NewScanner(strings.NewReader(profiles))
+	for sc.Scan() {
+		line := strings.TrimSpace(sc.Text())
+		idx := strings.LastIndexByte(line, '(')
+		if idx < 0 || !strings.HasSuffix(line, ")") {
+			continue
+		}
+		if apparmor.Profiles == nil {
+			apparmor.Profiles = map[string]int{}
+		}
+		apparmor.Profiles[line[idx+1:len(line)-1]]++
+	}
+
+	return apparmor, sc.Err()
+}
+
+// readSecureBoot reads the SecureBoot EFI variable. The content consists of
+// 4 bytes of attributes followed by a single byte that is 1 when Secure Boot
+// is enabled. It returns nil if the variable is not available.
+func readSecureBoot(path string) (*bool, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) || os.IsPermission(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if len(content) < 5 {
+		return nil, fmt.Errorf("unexpected SecureBoot variable length %d", len(content))
+	}
+
+	enabled := content[4] == 1
+	return &enabled, nil
+}
+
+// readCPUVulnerabilities returns the contents of each file in dir
+// (/sys/devices/system/cpu/vulnerabilities) keyed by file name.
+func readCPUVulnerabilities(dir string) (map[string]string, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	vulns := make(map[string]string, len(entries))
+	for _, e := range entries {
+		v, ok, err := readOptional(filepath.Join(dir, e.Name()))
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			vulns[e.Name()] = v
+		}
+	}
+
+	return vulns, nil
+}
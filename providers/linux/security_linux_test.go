@@ -0,0 +1,74 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package linux
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/go-sysinfo/types"
+)
+
+func TestHostSecurityPostureSELinux(t *testing.T) {
+	host, err := newLinuxSystem("testdata/fedora30").Host()
+	if err != nil {
+		t.Logf("could not get all host info: %v", err)
+	}
+
+	info, err := host.(types.SecurityPosture).SecurityPosture()
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"lockdown", "capability", "yama", "selinux", "bpf", "landlock"}, info.LSMs)
+	assert.Equal(t, &types.SELinuxInfo{
+		Mode:          "enforcing",
+		PolicyType:    "targeted",
+		PolicyVersion: 33,
+		MLS:           true,
+	}, info.SELinux)
+	assert.Nil(t, info.AppArmor)
+	assert.Equal(t, "none", info.Lockdown)
+	assert.EqualValues(t, 12288, info.Tainted)
+	assert.Equal(t, []string{"oot_module", "unsigned_module"}, info.TaintFlags)
+	if assert.NotNil(t, info.SecureBoot) {
+		assert.True(t, *info.SecureBoot)
+	}
+	assert.Len(t, info.CPUVulnerabilities, 4)
+	assert.Equal(t, "Not affected", info.CPUVulnerabilities["meltdown"])
+}
+
+func TestHostSecurityPostureAppArmor(t *testing.T) {
+	host, err := newLinuxSystem("testdata/ubuntu1710").Host()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	info, err := host.(types.SecurityPosture).SecurityPosture()
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"capability", "yama", "apparmor"}, info.LSMs)
+	assert.Nil(t, info.SELinux)
+	assert.Equal(t, &types.AppArmorInfo{
+		Enabled:  true,
+		Profiles: map[string]int{"enforce": 5, "complain": 1},
+	}, info.AppArmor)
+	assert.Empty(t, info.Lockdown)
+	assert.Nil(t, info.SecureBoot)
+	assert.Nil(t, info.CPUVulnerabilities)
+}
@@ -0,0 +1,91 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package linux
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+)
+
+// smapsMetricNames maps the fields of /proc/[pid]/smaps and
+// /proc/[pid]/smaps_rollup to the keys returned by types.SmapsMetrics.
+var smapsMetricNames = map[string]string{
+	"Pss":           "pss",
+	"Pss_Anon":      "pss_anon",  // smaps_rollup only (since Linux 5.1).
+	"Pss_File":      "pss_file",  // smaps_rollup only (since Linux 5.1).
+	"Pss_Shmem":     "pss_shmem", // smaps_rollup only (since Linux 5.1).
+	"Shared_Clean":  "shared_clean",
+	"Shared_Dirty":  "shared_dirty",
+	"Private_Clean": "private_clean",
+	"Private_Dirty": "private_dirty",
+	"Swap":          "swap",
+	"SwapPss":       "swap_pss",
+}
+
+// readSmapsMetrics returns the detailed memory metrics of a process in bytes.
+// It reads the pre-summed smaps_rollup file (since Linux 4.14) and falls back
+// to summing the entries of the smaps file on older kernels. The unique set
+// size (USS) is reported as "uss", the sum of the private pages.
+func readSmapsMetrics(rollupPath, smapsPath string) (map[string]uint64, error) {
+	content, err := os.ReadFile(rollupPath)
+	if errors.Is(err, os.ErrNotExist) {
+		content, err = os.ReadFile(smapsPath)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	metrics, err := parseSmaps(content)
+	if err != nil {
+		return nil, err
+	}
+	metrics["uss"] = metrics["private_clean"] + metrics["private_dirty"]
+
+	return metrics, nil
+}
+
+// parseSmaps sums the values of the known fields over all mappings listed in
+// the content of a smaps or smaps_rollup file.
+func parseSmaps(content []byte) (map[string]uint64, error) {
+	metrics := map[string]uint64{}
+
+	var line []byte
+	for len(content) > 0 {
+		line, content, _ = bytes.Cut(content, []byte{'\n'})
+
+		// Mapping headers and VmFlags lines do not match any known field.
+		key, value, found := bytes.Cut(line, []byte{':'})
+		if !found {
+			continue
+		}
+		name, found := smapsMetricNames[string(key)]
+		if !found {
+			continue
+		}
+
+		v, err := parseBytesOrNumber(value)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse %v value of %v: %w", string(key), string(value), err)
+		}
+		metrics[name] += v
+	}
+
+	return metrics, nil
+}
@@ -0,0 +1,85 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package linux
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/go-sysinfo/types"
+)
+
+func TestProcessSmapsMetrics(t *testing.T) {
+	proc, err := newLinuxSystem("testdata/fedora40").Process(33925)
+	require.NoError(t, err)
+
+	metrics, err := proc.(types.SmapsMetrics).SmapsMetrics()
+	require.NoError(t, err)
+
+	// Memory reports the same metrics.
+	mem, err := proc.Memory()
+	require.NoError(t, err)
+	assert.Equal(t, metrics, mem.Metrics)
+
+	assert.Equal(t, map[string]uint64{
+		"pss":           61250 * 1024,
+		"pss_anon":      41892 * 1024,
+		"pss_file":      19142 * 1024,
+		"pss_shmem":     216 * 1024,
+		"shared_clean":  48120 * 1024,
+		"shared_dirty":  432 * 1024,
+		"private_clean": 13916 * 1024,
+		"private_dirty": 41868 * 1024,
+		"swap":          1024 * 1024,
+		"swap_pss":      512 * 1024,
+		"uss":           (13916 + 41868) * 1024,
+	}, metrics)
+}
+
+func TestReadSmapsMetricsFallback(t *testing.T) {
+	metrics, err := readSmapsMetrics(
+		"testdata/fedora40/proc/33925/does-not-exist",
+		"testdata/fedora40/proc/33925/smaps")
+	require.NoError(t, err)
+
+	assert.Equal(t, map[string]uint64{
+		"pss":           (4 + 41860 + 12) * 1024,
+		"shared_clean":  (8 + 160) * 1024,
+		"shared_dirty":  0,
+		"private_clean": 0,
+		"private_dirty": 41860 * 1024,
+		"swap":          1024 * 1024,
+		"swap_pss":      1024 * 1024,
+		"uss":           41860 * 1024,
+	}, metrics)
+}
+
+func TestProcessSmapsMetricsSelf(t *testing.T) {
+	proc, err := newLinuxSystem("").Self()
+	require.NoError(t, err)
+
+	metrics, err := proc.(types.SmapsMetrics).SmapsMetrics()
+	require.NoError(t, err)
+
+	assert.NotZero(t, metrics["pss"])
+	assert.NotZero(t, metrics["uss"])
+	// Private pages are fully accounted in the PSS of the same read.
+	assert.LessOrEqual(t, metrics["uss"], metrics["pss"])
+}
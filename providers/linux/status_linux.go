@@ -0,0 +1,220 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package linux
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/elastic/go-sysinfo/types"
+)
+
+// parseProcStatus parses the contents of /proc/[pid]/status. See proc(5) for
+// a description of the fields.
+func parseProcStatus(content []byte) (*types.ProcessStatus, error) {
+	var s types.ProcessStatus
+
+	memory := map[string]*uint64{
+		"VmPeak":       &s.VmPeak,
+		"VmSize":       &s.VmSize,
+		"VmLck":        &s.VmLck,
+		"VmPin":        &s.VmPin,
+		"VmHWM":        &s.VmHWM,
+		"VmRSS":        &s.VmRSS,
+		"RssAnon":      &s.RssAnon,
+		"RssFile":      &s.RssFile,
+		"RssShmem":     &s.RssShmem,
+		"VmData":       &s.VmData,
+		"VmStk":        &s.VmStk,
+		"VmExe":        &s.VmExe,
+		"VmLib":        &s.VmLib,
+		"VmPTE":        &s.VmPTE,
+		"VmSwap":       &s.VmSwap,
+		"HugetlbPages": &s.HugetlbPages,
+	}
+	signals := map[string]*uint64{
+		"SigPnd": &s.SigPending,
+		"ShdPnd": &s.SharedSigPending,
+		"SigBlk": &s.SigBlocked,
+		"SigIgn": &s.SigIgnored,
+		"SigCgt": &s.SigCaught,
+	}
+	ints := map[string]*int{
+		"Tgid":            &s.Tgid,
+		"Ngid":            &s.Ngid,
+		"Pid":             &s.PID,
+		"PPid":            &s.PPID,
+		"TracerPid":       &s.TracerPID,
+		"FDSize":          &s.FDSize,
+		"Threads":         &s.Threads,
+		"Seccomp_filters": &s.SeccompFilters,
+	}
+	counters := map[string]*uint64{
+		"voluntary_ctxt_switches":    &s.VoluntaryCtxtSwitches,
+		"nonvoluntary_ctxt_switches": &s.NonvoluntaryCtxtSwitches,
+	}
+	nsIDs := map[string]*[]int{
+		"NStgid": &s.NSTgid,
+		"NSpid":  &s.NSPid,
+		"NSpgid": &s.NSPgid,
+		"NSsid":  &s.NSSid,
+	}
+
+	err := parseKeyValue(content, ':', func(key, value []byte) error {
+		k := string(key)
+		if dst, found := memory[k]; found {
+			v, err := parseBytesOrNumber(value)
+			if err != nil {
+				return err
+			}
+			*dst = v
+			return nil
+		}
+		if dst, found := signals[k]; found {
+			v, err := strconv.ParseUint(string(value), 16, 64)
+			if err != nil {
+				return err
+			}
+			*dst = v
+			return nil
+		}
+		if dst, found := ints[k]; found {
+			v, err := strconv.Atoi(string(value))
+			if err != nil {
+				return err
+			}
+			*dst = v
+			return nil
+		}
+		if dst, found := counters[k]; found {
+			v, err := strconv.ParseUint(string(value), 10, 64)
+			if err != nil {
+				return err
+			}
+			*dst = v
+			return nil
+		}
+		if dst, found := nsIDs[k]; found {
+			ids, err := parseIntFields(value)
+			if err != nil {
+				return err
+			}
+			*dst = ids
+			return nil
+		}
+
+		switch k {
+		case "Name":
+			s.Name = string(value)
+		case "Umask":
+			s.Umask = string(value)
+		case "State":
+			// Format: "S (sleeping)"
+			state, name, _ := strings.Cut(string(value), " ")
+			s.State = state
+			s.StateName = strings.Trim(name, "()")
+		case "Uid", "Gid":
+			setStatusIDs(&s.User, k, value)
+		case "Groups":
+			s.Groups = strings.Fields(string(value))
+		case "SigQ":
+			// Format: "queued/limit"
+			queued, limit, _ := strings.Cut(string(value), "/")
+			s.SigQueued, _ = strconv.ParseUint(queued, 10, 64)
+			s.SigQueueLimit, _ = strconv.ParseUint(limit, 10, 64)
+		case "Cpus_allowed_list":
+			cpus, err := parseCPUList(string(value))
+			if err != nil {
+				return err
+			}
+			s.CPUsAllowed = cpus
+		case "Mems_allowed_list":
+			mems, err := parseCPUList(string(value))
+			if err != nil {
+				return err
+			}
+			s.MemsAllowed = mems
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Capabilities and seccomp state are decoded by their own parsers from
+	// the same content.
+	capabilities, err := readCapabilities(content)
+	if err != nil {
+		return nil, err
+	}
+	s.Capabilities = *capabilities
+
+	seccomp, err := readSeccompFields(content)
+	if err != nil {
+		return nil, err
+	}
+	s.Seccomp = *seccomp
+
+	return &s, nil
+}
+
+// parseStatusUser parses only the Uid and Gid lines of /proc/[pid]/status.
+func parseStatusUser(content []byte) (types.UserInfo, error) {
+	var user types.UserInfo
+	err := parseKeyValue(content, ':', func(key, value []byte) error {
+		if k := string(key); k == "Uid" || k == "Gid" {
+			setStatusIDs(&user, k, value)
+		}
+		return nil
+	})
+	if err != nil {
+		return user, fmt.Errorf("error parsing key-values in user data: %w", err)
+	}
+	return user, nil
+}
+
+// setStatusIDs stores the real, effective and saved IDs from a Uid or Gid
+// line in user.
+func setStatusIDs(user *types.UserInfo, key string, value []byte) {
+	ids := strings.Fields(string(value))
+	if len(ids) < 3 {
+		return
+	}
+	switch key {
+	case "Uid":
+		user.UID, user.EUID, user.SUID = ids[0], ids[1], ids[2]
+	case "Gid":
+		user.GID, user.EGID, user.SGID = ids[0], ids[1], ids[2]
+	}
+}
+
+// parseIntFields parses a whitespace separated list of integers.
+func parseIntFields(value []byte) ([]int, error) {
+	fields := bytes.Fields(value)
+	ids := make([]int, 0, len(fields))
+	for _, f := range fields {
+		id, err := strconv.Atoi(string(f))
+		if err != nil {
+			return nil, err
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
@@ -0,0 +1,105 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package linux
+
+import (
+	"os"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/go-sysinfo/types"
+)
+
+func TestParseProcStatus(t *testing.T) {
+	s, err := parseProcStatus(testProcStatus)
+	require.NoError(t, err)
+
+	assert.Equal(t, "zsh", s.Name)
+	assert.Equal(t, "0022", s.Umask)
+	assert.Equal(t, "S", s.State)
+	assert.Equal(t, "sleeping", s.StateName)
+	assert.Equal(t, 4023363, s.Tgid)
+	assert.Equal(t, 4023363, s.PID)
+	assert.Equal(t, 4023357, s.PPID)
+	assert.Zero(t, s.TracerPID)
+	assert.Equal(t, types.UserInfo{
+		UID: "1000", EUID: "1000", SUID: "1000",
+		GID: "1000", EGID: "1000", SGID: "1000",
+	}, s.User)
+	assert.Equal(t, 64, s.FDSize)
+	assert.Len(t, s.Groups, 15)
+	assert.Equal(t, "24", s.Groups[0])
+	assert.Equal(t, []int{4023363}, s.NSPid)
+
+	assert.EqualValues(t, 15596*1024, s.VmPeak)
+	assert.EqualValues(t, 9060*1024, s.VmHWM)
+	assert.EqualValues(t, 8716*1024, s.VmRSS)
+	assert.EqualValues(t, 3828*1024, s.RssAnon)
+	assert.EqualValues(t, 4888*1024, s.RssFile)
+	assert.Zero(t, s.VmSwap)
+
+	assert.Equal(t, 1, s.Threads)
+	assert.EqualValues(t, 126683, s.SigQueueLimit)
+	assert.EqualValues(t, 0x2, s.SigBlocked)
+	assert.EqualValues(t, 0x384000, s.SigIgnored)
+	assert.EqualValues(t, 0x8013003, s.SigCaught)
+
+	assert.Empty(t, s.Capabilities.Effective)
+	assert.Len(t, s.Capabilities.Bounding, 41)
+	assert.Equal(t, "disabled", s.Seccomp.Mode)
+	if assert.NotNil(t, s.Seccomp.NoNewPrivs) {
+		assert.False(t, *s.Seccomp.NoNewPrivs)
+	}
+
+	assert.Equal(t, []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11}, s.CPUsAllowed)
+	assert.Equal(t, []int{0}, s.MemsAllowed)
+	assert.EqualValues(t, 223, s.VoluntaryCtxtSwitches)
+	assert.EqualValues(t, 25, s.NonvoluntaryCtxtSwitches)
+}
+
+func TestParseProcStatusInvalid(t *testing.T) {
+	content := []byte("Name:\tzsh\nUid:\t1000\t1001\t1002\t1003\nGid:\t100\t101\t102\t103\nVmRSS:\tabc kB\n")
+
+	_, err := parseProcStatus(content)
+	assert.Error(t, err)
+
+	// User only depends on the Uid and Gid lines.
+	user, err := parseStatusUser(content)
+	require.NoError(t, err)
+	assert.Equal(t, types.UserInfo{
+		UID: "1000", EUID: "1001", SUID: "1002",
+		GID: "100", EGID: "101", SGID: "102",
+	}, user)
+}
+
+func TestProcessStatusSelf(t *testing.T) {
+	proc, err := newLinuxSystem("").Self()
+	require.NoError(t, err)
+
+	s, err := proc.(types.Status).Status()
+	require.NoError(t, err)
+
+	assert.Equal(t, os.Getpid(), s.PID)
+	assert.Equal(t, os.Getppid(), s.PPID)
+	assert.Equal(t, strconv.Itoa(os.Geteuid()), s.User.EUID)
+	assert.NotZero(t, s.VmRSS)
+	assert.NotZero(t, s.Threads)
+}
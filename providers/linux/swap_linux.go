@@ -0,0 +1,211 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package linux
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/elastic/go-sysinfo/types"
+)
+
+const (
+	sysBlockPath       = "sys/block"
+	sysZswapParamsPath = "sys/module/zswap/parameters"
+	debugfsZswapPath   = "sys/kernel/debug/zswap"
+)
+
+// parseSwaps parses the contents of /proc/swaps. Sizes are reported in kB.
+func parseSwaps(content []byte) ([]types.SwapDevice, error) {
+	var devices []types.SwapDevice
+
+	sc := bufio.NewScanner(bytes.NewReader(content))
+	for n := 0; sc.Scan(); n++ {
+		fields := strings.Fields(sc.Text())
+		// Skip the header line.
+		if n == 0 || len(fields) == 0 {
+			continue
+		}
+		if len(fields) != 5 {
+			return nil, fmt.Errorf("unexpected line format %q", sc.Text())
+		}
+
+		size, err := strconv.ParseUint(fields[2], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse size: %w", err)
+		}
+		used, err := strconv.ParseUint(fields[3], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse used: %w", err)
+		}
+		priority, err := strconv.Atoi(fields[4])
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse priority: %w", err)
+		}
+
+		path := unescapeOctal(fields[0])
+		devices = append(devices, types.SwapDevice{
+			Path:     path,
+			Type:     fields[1],
+			Size:     size * 1024,
+			Used:     used * 1024,
+			Priority: priority,
+			ZRAM:     strings.HasPrefix(filepath.Base(path), "zram"),
+		})
+	}
+
+	return devices, sc.Err()
+}
+
+// unescapeOctal replaces the octal escape sequences (e.g. \040 for a space)
+// that the kernel uses for white-space in paths.
+func unescapeOctal(s string) string {
+	if !strings.Contains(s, `\`) {
+		return s
+	}
+
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\\' && i+4 <= len(s) {
+			if v, err := strconv.ParseUint(s[i+1:i+4], 8, 8); err == nil {
+				b.WriteByte(byte(v))
+				i += 3
+				continue
+			}
+		}
+		b.WriteByte(s[i])
+	}
+	return b.String()
+}
+
+// readZRAMDevices reads the stats of the zram devices in blockDir (/sys/block).
+func readZRAMDevices(blockDir string) ([]types.ZRAMDevice, error) {
+	dirs, err := filepath.Glob(filepath.Join(blockDir, "zram[0-9]*"))
+	if err != nil {
+		return nil, err
+	}
+
+	var devices []types.ZRAMDevice
+	for _, dir := range dirs {
+		dev := types.ZRAMDevice{Name: filepath.Base(dir)}
+
+		if dev.DiskSize, err = readUint(filepath.Join(dir, "disksize")); err != nil {
+			return nil, fmt.Errorf("error reading disksize of %v: %w", dev.Name, err)
+		}
+
+		if algorithm, err := os.ReadFile(filepath.Join(dir, "comp_algorithm")); err == nil {
+			dev.Algorithm = selectedMode(string(algorithm))
+		}
+
+		content, err := os.ReadFile(filepath.Join(dir, "mm_stat"))
+		if err != nil {
+			return nil, fmt.Errorf("error reading mm_stat of %v: %w", dev.Name, err)
+		}
+		if err = parseZRAMMMStat(content, &dev); err != nil {
+			return nil, fmt.Errorf("error parsing mm_stat of %v: %w", dev.Name, err)
+		}
+
+		devices = append(devices, dev)
+	}
+
+	sort.Slice(devices, func(i, j int) bool { return devices[i].Name < devices[j].Name })
+	return devices, nil
+}
+
+// parseZRAMMMStat parses the mm_stat file of a zram device. The columns are
+// documented in Documentation/admin-guide/blockdev/zram.rst. Newer kernels
+// append columns which are ignored.
+func parseZRAMMMStat(content []byte, dev *types.ZRAMDevice) error {
+	fields := strings.Fields(string(content))
+	columns := []*uint64{
+		&dev.OrigDataSize,
+		&dev.ComprDataSize,
+		&dev.MemUsedTotal,
+		&dev.MemLimit,
+		&dev.MemUsedMax,
+		&dev.SamePages,
+		&dev.PagesCompacted,
+		&dev.HugePages, // (since Linux 5.2)
+	}
+
+	for i, dst := range columns {
+		if i >= len(fields) {
+			break
+		}
+		v, err := strconv.ParseUint(fields[i], 10, 64)
+		if err != nil {
+			return err
+		}
+		*dst = v
+	}
+
+	return nil
+}
+
+// readZswap reads the zswap parameters from paramsDir
+// (/sys/module/zswap/parameters) and its counters from debugDir
+// (/sys/kernel/debug/zswap). It returns nil if zswap is not supported.
+// The counters are skipped if debugfs is not mounted or not readable.
+func readZswap(paramsDir, debugDir string) (*types.ZswapInfo, error) {
+	enabled, err := os.ReadFile(filepath.Join(paramsDir, "enabled"))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	zswap := &types.ZswapInfo{
+		Enabled: strings.TrimSpace(string(enabled)) == "Y",
+	}
+	if v, err := os.ReadFile(filepath.Join(paramsDir, "compressor")); err == nil {
+		zswap.Compressor = strings.TrimSpace(string(v))
+	}
+	if v, err := os.ReadFile(filepath.Join(paramsDir, "zpool")); err == nil {
+		zswap.Zpool = strings.TrimSpace(string(v))
+	}
+	if v, err := readUint(filepath.Join(paramsDir, "max_pool_percent")); err == nil {
+		zswap.MaxPoolPercent = v
+	}
+
+	entries, err := os.ReadDir(debugDir)
+	if err != nil {
+		return zswap, nil
+	}
+	for _, e := range entries {
+		if e.IsDir() {
+			continue
+		}
+		v, err := readUint(filepath.Join(debugDir, e.Name()))
+		if err != nil {
+			continue
+		}
+		if zswap.Stats == nil {
+			zswap.Stats = map[string]uint64{}
+		}
+		zswap.Stats[e.Name()] = v
+	}
+
+	return zswap, nil
+}
@@ -0,0 +1,83 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package linux
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/go-sysinfo/types"
+)
+
+func TestHostSwap(t *testing.T) {
+	host, err := newLinuxSystem("testdata/fedora30").Host()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	info, err := host.(types.Swap).Swap()
+	require.NoError(t, err)
+
+	assert.Equal(t, []types.SwapDevice{
+		{Path: "/dev/zram0", Type: "partition", Size: 8388604 * 1024, Used: 1048576 * 1024, Priority: 100, ZRAM: true},
+		{Path: "/dev/nvme0n1p3", Type: "partition", Size: 16777212 * 1024, Priority: -2},
+		{Path: "/var/swap file", Type: "file", Size: 2097148 * 1024, Priority: -3},
+	}, info.Devices)
+
+	assert.Equal(t, []types.ZRAMDevice{
+		{
+			Name:           "zram0",
+			DiskSize:       8589934592,
+			Algorithm:      "zstd",
+			OrigDataSize:   1234567168,
+			ComprDataSize:  315621376,
+			MemUsedTotal:   330432512,
+			MemUsedMax:     401342464,
+			SamePages:      12345,
+			PagesCompacted: 321,
+			HugePages:      42,
+		},
+	}, info.ZRAM)
+
+	assert.Equal(t, &types.ZswapInfo{
+		Enabled:        false,
+		Compressor:     "zstd",
+		Zpool:          "zsmalloc",
+		MaxPoolPercent: 20,
+		Stats: map[string]uint64{
+			"pool_total_size":    0,
+			"stored_pages":       0,
+			"written_back_pages": 0,
+		},
+	}, info.Zswap)
+}
+
+func TestParseSwapsEmpty(t *testing.T) {
+	devices, err := parseSwaps([]byte("Filename\t\t\t\tType\t\tSize\t\tUsed\t\tPriority\n"))
+	require.NoError(t, err)
+	assert.Empty(t, devices)
+}
+
+func TestUnescapeOctal(t *testing.T) {
+	assert.Equal(t, "/mnt/my swap", unescapeOctal(`/mnt/my\040swap`))
+	assert.Equal(t, "/mnt/a\tb", unescapeOctal(`/mnt/a\011b`))
+	assert.Equal(t, `/mnt/x\0`, unescapeOctal(`/mnt/x\0`))
+	assert.Equal(t, "/dev/sda2", unescapeOctal("/dev/sda2"))
+}
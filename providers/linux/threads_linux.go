@@ -0,0 +1,91 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package linux
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"syscall"
+
+	"github.com/prometheus/procfs"
+
+	"github.com/elastic/go-sysinfo/types"
+)
+
+// schedulingPolicyNames is mapping of SCHED_* policy values to names.
+var schedulingPolicyNames = map[uint]string{
+	0: "normal", // SCHED_OTHER
+	1: "fifo",
+	2: "rr",
+	3: "batch",
+	4: "iso", // Reserved, not implemented by mainline kernels.
+	5: "idle",
+	6: "deadline",
+}
+
+func schedulingPolicyName(policy uint) string {
+	name, found := schedulingPolicyNames[policy]
+	if found {
+		return name
+	}
+
+	return strconv.FormatUint(uint64(policy), 10)
+}
+
+// readThread reads the information about a thread from
+// /proc/[pid]/task/[tid]/stat and /proc/[pid]/task/[tid]/status.
+func readThread(thread procfs.Proc, statusPath string) (types.ThreadInfo, error) {
+	stat, err := thread.Stat()
+	if err != nil {
+		return types.ThreadInfo{}, err
+	}
+
+	content, err := os.ReadFile(statusPath)
+	if err != nil {
+		return types.ThreadInfo{}, err
+	}
+	status, err := parseProcStatus(content)
+	if err != nil {
+		return types.ThreadInfo{}, fmt.Errorf("error parsing status data: %w", err)
+	}
+
+	return types.ThreadInfo{
+		TID:   thread.PID,
+		Name:  stat.Comm,
+		State: stat.State,
+		CPU: types.CPUTimes{
+			User:   ticksToDuration(uint64(stat.UTime)),
+			System: ticksToDuration(uint64(stat.STime)),
+		},
+		LastCPU:                  int(stat.Processor),
+		VoluntaryCtxtSwitches:    status.VoluntaryCtxtSwitches,
+		NonvoluntaryCtxtSwitches: status.NonvoluntaryCtxtSwitches,
+		Policy:                   schedulingPolicyName(stat.Policy),
+		Priority:                 stat.Priority,
+		Nice:                     stat.Nice,
+		RTPriority:               int(stat.RTPriority),
+	}, nil
+}
+
+// isExited returns true if the error was caused by a thread or process that
+// exited while it was read.
+func isExited(err error) bool {
+	return errors.Is(err, os.ErrNotExist) || errors.Is(err, syscall.ESRCH)
+}
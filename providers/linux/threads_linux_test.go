@@ -0,0 +1,95 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package linux
+
+import (
+	"os"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/go-sysinfo/types"
+)
+
+func TestProcessThreads(t *testing.T) {
+	proc, err := newLinuxSystem("testdata/fedora40").Process(33925)
+	require.NoError(t, err)
+
+	threads, err := proc.(types.Threads).Threads()
+	require.NoError(t, err)
+	require.Len(t, threads, 2)
+	sort.Slice(threads, func(i, j int) bool { return threads[i].TID < threads[j].TID })
+
+	assert.Equal(t, types.ThreadInfo{
+		TID:   33925,
+		Name:  "rpc.statd",
+		State: "S",
+		CPU: types.CPUTimes{
+			User: 10 * time.Millisecond,
+		},
+		LastCPU:                  3,
+		VoluntaryCtxtSwitches:    1042,
+		NonvoluntaryCtxtSwitches: 17,
+		Policy:                   "normal",
+		Priority:                 20,
+	}, threads[0])
+
+	assert.Equal(t, types.ThreadInfo{
+		TID:   33926,
+		Name:  "statd-worker",
+		State: "R",
+		CPU: types.CPUTimes{
+			User:   2500 * time.Millisecond,
+			System: 400 * time.Millisecond,
+		},
+		LastCPU:                  5,
+		VoluntaryCtxtSwitches:    3,
+		NonvoluntaryCtxtSwitches: 8812,
+		Policy:                   "fifo",
+		Priority:                 -11,
+		RTPriority:               10,
+	}, threads[1])
+}
+
+func TestProcessThreadsSelf(t *testing.T) {
+	proc, err := newLinuxSystem("").Self()
+	require.NoError(t, err)
+
+	threads, err := proc.(types.Threads).Threads()
+	require.NoError(t, err)
+
+	// The Go runtime always starts more than one thread.
+	assert.Greater(t, len(threads), 1)
+
+	var found bool
+	for _, thread := range threads {
+		if thread.TID == os.Getpid() {
+			found = true
+		}
+	}
+	assert.True(t, found, "main thread not found")
+}
+
+func TestSchedulingPolicyName(t *testing.T) {
+	assert.Equal(t, "normal", schedulingPolicyName(0))
+	assert.Equal(t, "deadline", schedulingPolicyName(6))
+	assert.Equal(t, "42", schedulingPolicyName(42))
+}
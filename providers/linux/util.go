@@ -24,12 +24,14 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
 )
 
 // parseKeyValue parses key/val pairs separated by the provided separator from
 // each line in content and invokes the callback. White-space is trimmed from
 // val. Empty lines are ignored. All non-empty lines must contain the separator
-// otherwise an error is returned.
+// otherwise an error is returned. Parsing stops at the first error returned
+// by the callback.
 func parseKeyValue(content []byte, separator byte, callback func(key, value []byte) error) error {
 	var line []byte
 
@@ -44,7 +46,9 @@ func parseKeyValue(content []byte, separator byte, callback func(key, value []by
 			return fmt.Errorf("separator %q not found", separator)
 		}
 
-		callback(key, bytes.TrimSpace(value))
+		if err := callback(key, bytes.TrimSpace(value)); err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -118,3 +122,46 @@ func parseBytesOrNumber(data []byte) (uint64, error) {
 
 	return num * multiplier, nil
 }
+
+// readUint reads a file containing a single unsigned decimal integer.
+func readUint(path string) (uint64, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return 0, err
+	}
+
+	return strconv.ParseUint(string(bytes.TrimSpace(content)), 10, 64)
+}
+
+// parseCPUList parses a list of CPU or node numbers in the kernel's list
+// format (e.g. "0-3,8,10-11") as used by cpulist files and the
+// Cpus_allowed_list field of /proc/[pid]/status.
+func parseCPUList(s string) ([]int, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return nil, nil
+	}
+
+	var list []int
+	for _, r := range strings.Split(s, ",") {
+		first, last, isRange := strings.Cut(r, "-")
+
+		start, err := strconv.Atoi(first)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse list %q: %w", s, err)
+		}
+
+		end := start
+		if isRange {
+			if end, err = strconv.Atoi(last); err != nil {
+				return nil, fmt.Errorf("failed to parse list %q: %w", s, err)
+			}
+		}
+
+		for i := start; i <= end; i++ {
+			list = append(list, i)
+		}
+	}
+
+	return list, nil
+}
@@ -18,6 +18,7 @@
 package linux
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -77,6 +78,22 @@ func TestParseKeyValueEOL(t *testing.T) {
 	}, vals)
 }
 
+func TestParseKeyValueCallbackError(t *testing.T) {
+	errStop := errors.New("stop")
+	var keys []string
+	err := parseKeyValue([]byte(
+		"Name:	zsh\nUmask:	0022\nState:	S (sleeping)\n",
+	), ':', func(key, value []byte) error {
+		keys = append(keys, string(key))
+		if string(key) == "Umask" {
+			return errStop
+		}
+		return nil
+	})
+	assert.ErrorIs(t, err, errStop)
+	assert.Equal(t, []string{"Name", "Umask"}, keys)
+}
+
 // from cat /proc/$$/status
 var testProcStatus = []byte(`Name:	zsh
 Umask:	0022
@@ -160,3 +177,22 @@ func FuzzParseKeyValue(f *testing.F) {
 		})
 	})
 }
+
+func TestParseCPUList(t *testing.T) {
+	tests := map[string][]int{
+		"":           nil,
+		"0\n":        {0},
+		"0-3":        {0, 1, 2, 3},
+		"0-1,4,6-7":  {0, 1, 4, 6, 7},
+		" 2,10-11\n": {2, 10, 11},
+	}
+	for in, expected := range tests {
+		list, err := parseCPUList(in)
+		if assert.NoError(t, err, in) {
+			assert.Equal(t, expected, list, in)
+		}
+	}
+
+	_, err := parseCPUList("0-x")
+	assert.Error(t, err)
+}
@@ -0,0 +1,178 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+//go:build linux || darwin || aix
+
+package shared
+
+import (
+	"bufio"
+	"bytes"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/elastic/go-sysinfo/types"
+)
+
+const (
+	resolvConfPath        = "/etc/resolv.conf"
+	systemdResolvConfPath = "/run/systemd/resolve/resolv.conf"
+	nsswitchConfPath      = "/etc/nsswitch.conf"
+	hostsPath             = "/etc/hosts"
+)
+
+// localResolvConfPath is the resolv.conf file used to describe the resolver
+// configuration in FQDN lookup errors. It can be replaced for testing purposes.
+var localResolvConfPath = resolvConfPath
+
+// DNSConfig reads the name resolution configuration from resolv.conf(5),
+// nsswitch.conf(5) and hosts(5) files below the given root path. Use an
+// empty hostfs to read the configuration of the local system. Files that do
+// not exist are skipped.
+func DNSConfig(hostfs string) (*types.DNSConfigInfo, error) {
+	var info types.DNSConfigInfo
+
+	content, err := readOptionalFile(filepath.Join(hostfs, resolvConfPath))
+	if err != nil {
+		return nil, err
+	}
+	if content != nil {
+		info.ResolvConf = parseResolvConf(content)
+	}
+
+	content, err = readOptionalFile(filepath.Join(hostfs, systemdResolvConfPath))
+	if err != nil {
+		return nil, err
+	}
+	if content != nil {
+		info.SystemdResolved = parseResolvConf(content)
+	}
+
+	content, err = readOptionalFile(filepath.Join(hostfs, nsswitchConfPath))
+	if err != nil {
+		return nil, err
+	}
+	info.NSSwitchHosts = parseNSSwitchDatabase(content, "hosts")
+
+	content, err = readOptionalFile(filepath.Join(hostfs, hostsPath))
+	if err != nil {
+		return nil, err
+	}
+	info.Hosts = parseHosts(content)
+
+	return &info, nil
+}
+
+// readOptionalFile returns the contents of the file, or nil if it does
+// not exist.
+func readOptionalFile(path string) ([]byte, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to read %v: %w", path, err)
+	}
+	return content, nil
+}
+
+func parseResolvConf(content []byte) *types.ResolvConf {
+	conf := &types.ResolvConf{}
+
+	sc := bufio.NewScanner(bytes.NewReader(content))
+	for sc.Scan() {
+		line := strings.TrimSpace(sc.Text())
+		if line == "" || line[0] == '#' || line[0] == ';' {
+			continue
+		}
+
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			continue
+		}
+
+		switch fields[0] {
+		case "nameserver":
+			conf.Nameservers = append(conf.Nameservers, fields[1])
+		case "domain":
+			// The domain and search keywords are mutually exclusive.
+			// If more than one instance is present the last one wins.
+			conf.Search = []string{fields[1]}
+		case "search":
+			conf.Search = fields[1:]
+		case "options":
+			conf.Options = append(conf.Options, fields[1:]...)
+		}
+	}
+
+	return conf
+}
+
+// parseNSSwitchDatabase returns the list of sources (and actions) configured
+// for the given database in nsswitch.conf(5).
+func parseNSSwitchDatabase(content []byte, database string) []string {
+	sc := bufio.NewScanner(bytes.NewReader(content))
+	for sc.Scan() {
+		line, _, _ := strings.Cut(sc.Text(), "#")
+		key, value, ok := strings.Cut(line, ":")
+		if !ok || strings.TrimSpace(key) != database {
+			continue
+		}
+
+		return strings.Fields(value)
+	}
+
+	return nil
+}
+
+func parseHosts(content []byte) []types.HostsEntry {
+	var entries []types.HostsEntry
+
+	sc := bufio.NewScanner(bytes.NewReader(content))
+	for sc.Scan() {
+		line, _, _ := strings.Cut(sc.Text(), "#")
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			continue
+		}
+
+		entries = append(entries, types.HostsEntry{
+			IP:        fields[0],
+			Hostnames: fields[1:],
+		})
+	}
+
+	return entries
+}
+
+// resolverSummary describes the local resolver configuration. It is
+// appended to FQDN lookup errors to help explain why they failed.
+func resolverSummary() string {
+	content, err := os.ReadFile(localResolvConfPath)
+	if err != nil {
+		return fmt.Sprintf("resolver config unavailable: %v", err)
+	}
+
+	conf := parseResolvConf(content)
+	if len(conf.Nameservers) == 0 {
+		return fmt.Sprintf("no nameservers configured in %v", localResolvConfPath)
+	}
+	return fmt.Sprintf("resolver config from %v: nameservers=%v search=%v",
+		localResolvConfPath, conf.Nameservers, conf.Search)
+}
@@ -0,0 +1,109 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+//go:build linux || darwin
+
+package shared
+
+import (
+	"context"
+	"errors"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/go-sysinfo/types"
+)
+
+func TestParseResolvConf(t *testing.T) {
+	conf := parseResolvConf([]byte(`# comment
+; another comment
+nameserver 10.0.0.1
+nameserver   fd00::1
+domain example.com
+search corp.example.com example.com
+options ndots:2 timeout:1
+options rotate
+nameserver
+`))
+
+	assert.Equal(t, &types.ResolvConf{
+		Nameservers: []string{"10.0.0.1", "fd00::1"},
+		Search:      []string{"corp.example.com", "example.com"},
+		Options:     []string{"ndots:2", "timeout:1", "rotate"},
+	}, conf)
+
+	conf = parseResolvConf([]byte("search example.com\ndomain corp.example.com\n"))
+	assert.Equal(t, []string{"corp.example.com"}, conf.Search)
+}
+
+func TestParseNSSwitchDatabase(t *testing.T) {
+	content := []byte(`passwd: files
+# hosts: files
+hosts:  files dns # trailing comment
+`)
+	assert.Equal(t, []string{"files", "dns"}, parseNSSwitchDatabase(content, "hosts"))
+	assert.Nil(t, parseNSSwitchDatabase(content, "networks"))
+}
+
+func TestParseHosts(t *testing.T) {
+	entries := parseHosts([]byte(`127.0.0.1 localhost
+# 10.0.0.1 commented.example.com
+10.0.0.2	db.example.com db # database
+invalid
+`))
+
+	assert.Equal(t, []types.HostsEntry{
+		{IP: "127.0.0.1", Hostnames: []string{"localhost"}},
+		{IP: "10.0.0.2", Hostnames: []string{"db.example.com", "db"}},
+	}, entries)
+}
+
+func TestDNSConfigMissingFiles(t *testing.T) {
+	info, err := DNSConfig(t.TempDir())
+	require.NoError(t, err)
+	assert.Equal(t, &types.DNSConfigInfo{}, info)
+}
+
+func TestFQDNErrorIncludesResolverSummary(t *testing.T) {
+	defer func() {
+		defaultResolver = net.DefaultResolver
+		localResolvConfPath = resolvConfPath
+	}()
+
+	localResolvConfPath = filepath.Join(t.TempDir(), "resolv.conf")
+	require.NoError(t, os.WriteFile(localResolvConfPath, []byte("nameserver 10.0.0.1\nsearch example.com\n"), 0o644))
+
+	ctx := context.Background()
+	hostname := "short_hostname"
+	lookupIPErr := errors.New("lookup ip error")
+
+	defaultResolver = &mockResolver{}
+	defaultResolver.(*mockResolver).On("LookupCNAME", ctx, hostname).Once().Return("", nil)
+	defaultResolver.(*mockResolver).On("LookupIP", ctx, "ip", hostname).Once().Return([]net.IP{}, lookupIPErr)
+
+	_, err := fqdn(ctx, hostname)
+	assert.ErrorIs(t, err, lookupIPErr)
+	assert.ErrorContains(t, err, "nameservers=[10.0.0.1] search=[example.com]")
+
+	require.NoError(t, os.WriteFile(localResolvConfPath, []byte("# empty\n"), 0o644))
+	assert.Contains(t, resolverSummary(), "no nameservers configured")
+}
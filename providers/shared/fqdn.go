@@ -52,7 +52,8 @@ type resolver interface {
 //     successful result (after trimming any trailing period) as the FQDN.
 //
 //  4. If steps 2 and 3 both fail, an empty string is returned as the FQDN along with
-//     errors from those steps.
+//     errors from those steps and a summary of the nameservers configured in
+//     /etc/resolv.conf.
 func FQDNWithContext(ctx context.Context) (string, error) {
 	hostname, err := os.Hostname()
 	if err != nil {
@@ -110,5 +111,5 @@ func fqdn(ctx context.Context, hostname string) (string, error) {
 		return hostname, nil
 	}
 
-	return "", errs
+	return "", fmt.Errorf("%w (%s)", errs, resolverSummary())
 }
@@ -37,9 +37,14 @@ import (
 
 func init() {
 	registry.Register(windowsSystem{})
+	registry.Register(func(opts registry.ProviderOptions) registry.ProcessProvider {
+		return windowsSystem{redactor: opts.Redactor}
+	})
 }
 
-type windowsSystem struct{}
+type windowsSystem struct {
+	redactor *types.Redactor
+}
 
 func (s windowsSystem) Host() (types.Host, error) {
 	return newHost()
@@ -64,16 +64,17 @@ func (s windowsSystem) Processes() (procs []types.Process, err error) {
 }
 
 func (s windowsSystem) Process(pid int) (types.Process, error) {
-	return newProcess(pid)
+	return newProcess(pid, s.redactor)
 }
 
 func (s windowsSystem) Self() (types.Process, error) {
-	return newProcess(selfPID)
+	return newProcess(selfPID, s.redactor)
 }
 
 type process struct {
-	pid  int
-	info types.ProcessInfo
+	pid      int
+	info     types.ProcessInfo
+	redactor *types.Redactor
 }
 
 func (p *process) PID() int {
@@ -86,11 +87,11 @@ func (p *process) Parent() (types.Process, error) {
 		return nil, err
 	}
 
-	return newProcess(info.PPID)
+	return newProcess(info.PPID, p.redactor)
 }
 
-func newProcess(pid int) (*process, error) {
-	p := &process{pid: pid}
+func newProcess(pid int, redactor *types.Redactor) (*process, error) {
+	p := &process{pid: pid, redactor: redactor}
 	if err := p.init(); err != nil {
 		return nil, err
 	}
@@ -152,7 +153,7 @@ func (p *process) init() error {
 		PID:       p.pid,
 		PPID:      ppid,
 		Exe:       path,
-		Args:      args,
+		Args:      p.redactor.Args(args),
 		CWD:       cwd,
 		StartTime: time.Unix(0, creationTime.Nanoseconds()),
 	}
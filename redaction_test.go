@@ -0,0 +1,72 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package sysinfo
+
+import (
+	"runtime"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/elastic/go-sysinfo/types"
+)
+
+func TestRedactionFeaturesMatrix(t *testing.T) {
+	const GOOS = runtime.GOOS
+
+	process, err := Self(WithRedaction(types.DefaultRedactionConfig()))
+	if err == types.ErrNotImplemented {
+		assert.Nil(t, expectedProcessFeatures[GOOS], "unexpected ErrNotImplemented for %v", GOOS)
+		return
+	} else if err != nil {
+		t.Fatal(err)
+	}
+
+	features := processFeatures(process)
+	assert.Equal(t, expectedProcessFeatures[GOOS], &features)
+}
+
+func TestRedactionHostFS(t *testing.T) {
+	if runtime.GOOS != "linux" {
+		t.Skip("test is linux-only")
+	}
+
+	proc, err := Process(33925,
+		WithHostFS("providers/linux/testdata/fedora40"),
+		WithRedaction(types.DefaultRedactionConfig()))
+	require.NoError(t, err)
+
+	// The masking itself is covered by the provider tests, this only checks
+	// that the option reaches the provider.
+	env, err := proc.(types.Environment).Environment()
+	require.NoError(t, err)
+	_, redacted := types.RedactedBy(env["DB_PASSWORD"])
+	assert.True(t, redacted)
+
+	// The optional interfaces of the provider remain available.
+	ns, err := proc.(types.Namespaces).Namespaces()
+	require.NoError(t, err)
+	assert.NotZero(t, ns["pid"])
+
+	tree, err := ProcessTree(
+		WithHostFS("providers/linux/testdata/fedora40"),
+		WithRedaction(types.DefaultRedactionConfig()))
+	require.NoError(t, err)
+	assert.Len(t, tree.Descendants(1), 3)
+}
@@ -45,6 +45,19 @@ func WithHostFS(hostfs string) ProviderOption {
 	}
 }
 
+// WithRedaction returns a provider that masks secrets in process arguments
+// and environment variables before they are returned. Masked values record
+// the rule that matched them, see types.RedactedBy. Use
+// types.DefaultRedactionConfig() for a configuration that covers common
+// password, secret, token and key names. The processes are redacted by the
+// provider of the platform, so they implement the same optional process
+// interfaces as without redaction.
+func WithRedaction(config types.RedactionConfig) ProviderOption {
+	return func(po *registry.ProviderOptions) {
+		po.Redactor = types.NewRedactor(config)
+	}
+}
+
 // Go returns information about the Go runtime.
 func Go() types.GoInfo {
 	return types.GoInfo{
@@ -67,7 +80,8 @@ func applyOptsAndReturnProvider(opts ...ProviderOption) registry.ProviderOptions
 // Most of the exported functions here deal with processes,
 // so this just gets wrapped by all the external functions
 func setupProcessProvider(opts ...ProviderOption) (registry.ProcessProvider, error) {
-	provider := registry.GetProcessProvider(applyOptsAndReturnProvider(opts...))
+	options := applyOptsAndReturnProvider(opts...)
+	provider := registry.GetProcessProvider(options)
 	if provider == nil {
 		return nil, types.ErrNotImplemented
 	}
@@ -119,3 +133,26 @@ func Self(opts ...ProviderOption) (types.Process, error) {
 	}
 	return provider.Self()
 }
+
+// ProcessTree returns the parent/child forest of all processes, built from a
+// single scan of the process table. If process information collection is not
+// implemented for this platform then types.ErrNotImplemented is returned.
+func ProcessTree(opts ...ProviderOption) (*types.ProcessTree, error) {
+	processes, err := Processes(opts...)
+	if err != nil {
+		return nil, err
+	}
+	return types.NewProcessTree(processes), nil
+}
+
+// TopOOMScores returns the n processes that the out-of-memory killer would
+// select first, ordered by descending OOM score. If process information
+// collection is not implemented for this platform then
+// types.ErrNotImplemented is returned.
+func TopOOMScores(n int, opts ...ProviderOption) ([]types.OOMCandidate, error) {
+	processes, err := Processes(opts...)
+	if err != nil {
+		return nil, err
+	}
+	return types.TopOOMScores(processes, n), nil
+}
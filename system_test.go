@@ -99,9 +99,57 @@ func TestSystemProcessHostFS(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestProcessTreeHostFS(t *testing.T) {
+	if runtime.GOOS != "linux" {
+		t.Skip("test is linux-only")
+	}
+
+	tree, err := ProcessTree(WithHostFS("providers/linux/testdata/fedora40"))
+	require.NoError(t, err)
+
+	pids := func(nodes []*types.ProcessNode) []int {
+		var pids []int
+		for _, n := range nodes {
+			pids = append(pids, n.PID)
+		}
+		return pids
+	}
+	assert.Equal(t, []int{33925, 33931, 33932}, pids(tree.Descendants(1)))
+	assert.Equal(t, []int{33925, 1}, pids(tree.Ancestors(33932)))
+}
+
+func TestProcessTreeHostFSParentPIDReused(t *testing.T) {
+	if runtime.GOOS != "linux" {
+		t.Skip("test is linux-only")
+	}
+
+	tree, err := ProcessTree(WithHostFS("providers/linux/testdata/fedora40"))
+	require.NoError(t, err)
+
+	// The parent PID of 33940 belongs to a process that started after it.
+	var roots []int
+	for _, n := range tree.Roots {
+		roots = append(roots, n.PID)
+	}
+	assert.Equal(t, []int{1, 2, 33940}, roots)
+	assert.Nil(t, tree.Nodes[33940].Parent)
+	assert.Empty(t, tree.Nodes[33932].Children)
+}
+
+func TestTopOOMScoresHostFS(t *testing.T) {
+	if runtime.GOOS != "linux" {
+		t.Skip("test is linux-only")
+	}
+
+	top, err := TopOOMScores(2, WithHostFS("providers/linux/testdata/fedora40"))
+	require.NoError(t, err)
+	require.Len(t, top, 2)
+	assert.Equal(t, 33925, top[0].PID)
+	assert.Equal(t, 667, top[0].OOM.Score)
+}
+
 func TestProcessFeaturesMatrix(t *testing.T) {
 	const GOOS = runtime.GOOS
-	var features ProcessFeatures
 
 	process, err := Self()
 	if err == types.ErrNotImplemented {
@@ -110,18 +158,22 @@ func TestProcessFeaturesMatrix(t *testing.T) {
 	} else if err != nil {
 		t.Fatal(err)
 	}
-	features.ProcessInfo = true
+	features := processFeatures(process)
+
+	assert.Equal(t, expectedProcessFeatures[GOOS], &features)
+	logAsJSON(t, map[string]interface{}{
+		"features": features,
+	})
+}
 
+func processFeatures(process types.Process) ProcessFeatures {
+	features := ProcessFeatures{ProcessInfo: true}
 	_, features.Environment = process.(types.Environment)
 	_, features.OpenHandleEnumerator = process.(types.OpenHandleEnumerator)
 	_, features.OpenHandleCounter = process.(types.OpenHandleCounter)
 	_, features.Seccomp = process.(types.Seccomp)
 	_, features.Capabilities = process.(types.Capabilities)
-
-	assert.Equal(t, expectedProcessFeatures[GOOS], &features)
-	logAsJSON(t, map[string]interface{}{
-		"features": features,
-	})
+	return features
 }
 
 func TestSelf(t *testing.T) {
@@ -21,3 +21,11 @@ import "errors"
 
 // ErrNotImplemented represents an error for a function that is not implemented on a particular platform.
 var ErrNotImplemented = errors.New("unimplemented")
+
+// ErrParentPIDReused is returned when the parent PID of a process has been
+// reused by a process that started after it, so the original parent exited.
+var ErrParentPIDReused = errors.New("parent PID was reused by a newer process")
+
+// ErrNotGoExecutable is returned when Go build information is requested for
+// a process whose executable was not built by the Go toolchain.
+var ErrNotGoExecutable = errors.New("not a Go executable")
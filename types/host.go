@@ -19,6 +19,7 @@ package types
 
 import (
 	"context"
+	"net/netip"
 	"time"
 )
 
@@ -66,6 +67,81 @@ type NetworkCountersInfo struct {
 	Netstat Netstat `json:"netstat"`
 }
 
+// Routes is the interface that wraps the Routes method.
+// Routes returns the IPv4 and IPv6 routing tables.
+type Routes interface {
+	Routes() ([]Route, error)
+}
+
+// Address families reported in Route.Family.
+const (
+	RouteFamilyIPv4 = "ipv4"
+	RouteFamilyIPv6 = "ipv6"
+)
+
+// Route is a single entry of the kernel routing table.
+type Route struct {
+	Family      string       `json:"family"`          // Address family (ipv4 or ipv6).
+	Destination netip.Prefix `json:"destination"`     // Destination network.
+	Gateway     netip.Addr   `json:"gateway"`         // Next hop, unspecified for directly connected routes.
+	Interface   string       `json:"interface"`       // Name of the outgoing interface.
+	Metric      uint32       `json:"metric"`          // Route metric (lower is preferred).
+	Flags       []string     `json:"flags,omitempty"` // Decoded RTF_* flags (e.g. up, gateway, host).
+}
+
+// IsDefault returns true if the route matches all destinations of its
+// address family.
+func (r Route) IsDefault() bool {
+	return r.Destination.IsValid() && r.Destination.Bits() == 0
+}
+
+// DefaultGateway returns the default route with the lowest metric for the
+// given address family (RouteFamilyIPv4 or RouteFamilyIPv6). The second
+// return value is false if no default route with a gateway exists.
+func DefaultGateway(routes []Route, family string) (Route, bool) {
+	var (
+		best  Route
+		found bool
+	)
+	for _, r := range routes {
+		if r.Family != family || !r.IsDefault() || !r.Gateway.IsValid() || r.Gateway.IsUnspecified() {
+			continue
+		}
+		if !found || r.Metric < best.Metric {
+			best, found = r, true
+		}
+	}
+	return best, found
+}
+
+// DNSConfig is the interface that wraps the DNSConfig method.
+// DNSConfig returns the name resolution configuration of the host.
+type DNSConfig interface {
+	DNSConfig() (*DNSConfigInfo, error)
+}
+
+// DNSConfigInfo contains the name resolution configuration of a host.
+// Sections whose source file does not exist are left empty.
+type DNSConfigInfo struct {
+	ResolvConf      *ResolvConf  `json:"resolv_conf,omitempty"`      // Parsed /etc/resolv.conf.
+	SystemdResolved *ResolvConf  `json:"systemd_resolved,omitempty"` // Parsed /run/systemd/resolve/resolv.conf (upstream servers of systemd-resolved).
+	NSSwitchHosts   []string     `json:"nsswitch_hosts,omitempty"`   // Sources of the hosts database from /etc/nsswitch.conf.
+	Hosts           []HostsEntry `json:"hosts,omitempty"`            // Static entries from /etc/hosts.
+}
+
+// ResolvConf contains the resolver configuration from a resolv.conf(5) file.
+type ResolvConf struct {
+	Nameservers []string `json:"nameservers,omitempty"`
+	Search      []string `json:"search,omitempty"` // Search list. A "domain" directive is reported as a single entry.
+	Options     []string `json:"options,omitempty"`
+}
+
+// HostsEntry is a static host name mapping from a hosts(5) file.
+type HostsEntry struct {
+	IP        string   `json:"ip"`
+	Hostnames []string `json:"hostnames"` // Canonical name followed by aliases.
+}
+
 // VMStat is the interface wrapper for platforms that support /proc/vmstat.
 type VMStat interface {
 	VMStat() (*VMStatInfo, error)
@@ -85,6 +161,7 @@ type HostInfo struct {
 	Timezone           string    `json:"timezone"`                // System timezone.
 	TimezoneOffsetSec  int       `json:"timezone_offset_sec"`     // Timezone offset (seconds from UTC).
 	UniqueID           string    `json:"id,omitempty"`            // Unique ID of the host (optional).
+	Boot               *BootInfo `json:"boot,omitempty"`          // Information about the current boot (optional).
 }
 
 // Uptime returns the system uptime
@@ -92,6 +169,47 @@ func (host HostInfo) Uptime() time.Duration {
 	return time.Since(host.BootTime)
 }
 
+// BootInfo contains information about the current boot of the host.
+type BootInfo struct {
+	ID            string        `json:"id,omitempty"`             // Identifier that changes on every boot.
+	KernelImage   string        `json:"kernel_image,omitempty"`   // Path of the booted kernel image, if passed by the boot loader.
+	KernelCmdline KernelCmdline `json:"kernel_cmdline,omitempty"` // Kernel command line parameters.
+	Firmware      string        `json:"firmware,omitempty"`       // Firmware interface used to boot (uefi or bios), empty if unknown.
+}
+
+// KernelParameter is a parameter of the kernel command line. Value is empty
+// for flags without a value (e.g. quiet).
+type KernelParameter struct {
+	Key   string `json:"key"`
+	Value string `json:"value,omitempty"`
+}
+
+// KernelCmdline contains the kernel command line parameters in the order they
+// were passed. Repeated parameters are preserved.
+type KernelCmdline []KernelParameter
+
+// Lookup returns the value of the last occurrence of the given parameter,
+// which is the one that takes effect for most kernel parameters.
+func (c KernelCmdline) Lookup(key string) (string, bool) {
+	for i := len(c) - 1; i >= 0; i-- {
+		if c[i].Key == key {
+			return c[i].Value, true
+		}
+	}
+	return "", false
+}
+
+// Values returns the values of all occurrences of the given parameter.
+func (c KernelCmdline) Values(key string) []string {
+	var values []string
+	for _, p := range c {
+		if p.Key == key {
+			values = append(values, p.Value)
+		}
+	}
+	return values
+}
+
 // OSInfo contains basic OS information
 type OSInfo struct {
 	Type     string `json:"type"`               // OS Type (one of linux, macos, unix, windows).
@@ -131,6 +249,287 @@ type HostMemoryInfo struct {
 	Metrics      map[string]uint64 `json:"raw,omitempty"`       // Other memory related metrics.
 }
 
+// MemInfo is the interface that wraps the MemInfo method.
+// MemInfo returns the typed contents of /proc/meminfo.
+type MemInfo interface {
+	MemInfo() (*MemInfoDetail, error)
+}
+
+// MemInfoDetail contains parsed info from /proc/meminfo. All values are
+// specified in bytes except for the HugePages_* counters which are numbers
+// of pages. Fields that are not reported by the running kernel are zero.
+type MemInfoDetail struct {
+	MemTotal          uint64            `json:"mem_total_bytes" meminfo:"MemTotal"`         // Total usable physical memory.
+	MemFree           uint64            `json:"mem_free_bytes" meminfo:"MemFree"`           // Memory not used by the system.
+	MemAvailable      uint64            `json:"mem_available_bytes" meminfo:"MemAvailable"` // (since Linux 3.14) Estimate of memory available without swapping.
+	Buffers           uint64            `json:"buffers_bytes" meminfo:"Buffers"`            // Temporary storage for raw disk blocks.
+	Cached            uint64            `json:"cached_bytes" meminfo:"Cached"`              // Page cache, excluding SwapCached.
+	SwapCached        uint64            `json:"swap_cached_bytes" meminfo:"SwapCached"`     // Swapped out memory that is also still in RAM.
+	Active            uint64            `json:"active_bytes" meminfo:"Active"`
+	Inactive          uint64            `json:"inactive_bytes" meminfo:"Inactive"`
+	ActiveAnon        uint64            `json:"active_anon_bytes" meminfo:"Active(anon)"`     // (since Linux 2.6.28)
+	InactiveAnon      uint64            `json:"inactive_anon_bytes" meminfo:"Inactive(anon)"` // (since Linux 2.6.28)
+	ActiveFile        uint64            `json:"active_file_bytes" meminfo:"Active(file)"`     // (since Linux 2.6.28)
+	InactiveFile      uint64            `json:"inactive_file_bytes" meminfo:"Inactive(file)"` // (since Linux 2.6.28)
+	Unevictable       uint64            `json:"unevictable_bytes" meminfo:"Unevictable"`      // (since Linux 2.6.28)
+	Mlocked           uint64            `json:"mlocked_bytes" meminfo:"Mlocked"`              // (since Linux 2.6.28)
+	SwapTotal         uint64            `json:"swap_total_bytes" meminfo:"SwapTotal"`
+	SwapFree          uint64            `json:"swap_free_bytes" meminfo:"SwapFree"`
+	Zswap             uint64            `json:"zswap_bytes" meminfo:"Zswap"`                   // (since Linux 6.5) Memory consumed by the zswap backend (compressed size).
+	Zswapped          uint64            `json:"zswapped_bytes" meminfo:"Zswapped"`             // (since Linux 6.5) Amount of anonymous memory stored in zswap (original size).
+	Dirty             uint64            `json:"dirty_bytes" meminfo:"Dirty"`                   // Memory waiting to be written back to disk.
+	Writeback         uint64            `json:"writeback_bytes" meminfo:"Writeback"`           // Memory actively being written back to disk.
+	AnonPages         uint64            `json:"anon_pages_bytes" meminfo:"AnonPages"`          // (since Linux 2.6.18) Non-file backed pages mapped into user-space page tables.
+	Mapped            uint64            `json:"mapped_bytes" meminfo:"Mapped"`                 // Files which have been mapped into memory.
+	Shmem             uint64            `json:"shmem_bytes" meminfo:"Shmem"`                   // (since Linux 2.6.32) Memory used by shared memory and tmpfs(5).
+	KReclaimable      uint64            `json:"kreclaimable_bytes" meminfo:"KReclaimable"`     // (since Linux 4.20) Kernel allocations that the kernel will attempt to reclaim under memory pressure.
+	Slab              uint64            `json:"slab_bytes" meminfo:"Slab"`                     // In-kernel data structures cache.
+	SReclaimable      uint64            `json:"sreclaimable_bytes" meminfo:"SReclaimable"`     // (since Linux 2.6.19) Part of Slab that might be reclaimed.
+	SUnreclaim        uint64            `json:"sunreclaim_bytes" meminfo:"SUnreclaim"`         // (since Linux 2.6.19) Part of Slab that cannot be reclaimed.
+	KernelStack       uint64            `json:"kernel_stack_bytes" meminfo:"KernelStack"`      // (since Linux 2.6.32) Memory allocated to kernel stacks.
+	PageTables        uint64            `json:"page_tables_bytes" meminfo:"PageTables"`        // (since Linux 2.6.18) Memory dedicated to the lowest level of page tables.
+	SecPageTables     uint64            `json:"sec_page_tables_bytes" meminfo:"SecPageTables"` // (since Linux 6.3) Memory consumed by secondary page tables.
+	NFSUnstable       uint64            `json:"nfs_unstable_bytes" meminfo:"NFS_Unstable"`     // (since Linux 2.6.18)
+	Bounce            uint64            `json:"bounce_bytes" meminfo:"Bounce"`                 // (since Linux 2.6.18)
+	WritebackTmp      uint64            `json:"writeback_tmp_bytes" meminfo:"WritebackTmp"`    // (since Linux 2.6.26)
+	CommitLimit       uint64            `json:"commit_limit_bytes" meminfo:"CommitLimit"`      // (since Linux 2.6.10)
+	CommittedAS       uint64            `json:"committed_as_bytes" meminfo:"Committed_AS"`     // Memory currently allocated on the system.
+	VmallocTotal      uint64            `json:"vmalloc_total_bytes" meminfo:"VmallocTotal"`
+	VmallocUsed       uint64            `json:"vmalloc_used_bytes" meminfo:"VmallocUsed"`
+	VmallocChunk      uint64            `json:"vmalloc_chunk_bytes" meminfo:"VmallocChunk"`
+	Percpu            uint64            `json:"percpu_bytes" meminfo:"Percpu"`                        // (since Linux 4.16)
+	HardwareCorrupted uint64            `json:"hardware_corrupted_bytes" meminfo:"HardwareCorrupted"` // (since Linux 2.6.32)
+	AnonHugePages     uint64            `json:"anon_huge_pages_bytes" meminfo:"AnonHugePages"`        // (since Linux 2.6.38) Non-file backed huge pages.
+	ShmemHugePages    uint64            `json:"shmem_huge_pages_bytes" meminfo:"ShmemHugePages"`      // (since Linux 4.8)
+	ShmemPmdMapped    uint64            `json:"shmem_pmd_mapped_bytes" meminfo:"ShmemPmdMapped"`      // (since Linux 4.8)
+	FileHugePages     uint64            `json:"file_huge_pages_bytes" meminfo:"FileHugePages"`        // (since Linux 5.4)
+	FilePmdMapped     uint64            `json:"file_pmd_mapped_bytes" meminfo:"FilePmdMapped"`        // (since Linux 5.4)
+	CmaTotal          uint64            `json:"cma_total_bytes" meminfo:"CmaTotal"`                   // (since Linux 3.1)
+	CmaFree           uint64            `json:"cma_free_bytes" meminfo:"CmaFree"`                     // (since Linux 3.1)
+	Unaccepted        uint64            `json:"unaccepted_bytes" meminfo:"Unaccepted"`                // (since Linux 6.5)
+	HugePagesTotal    uint64            `json:"hugepages_total" meminfo:"HugePages_Total"`            // Size of the pool of huge pages (count, not bytes).
+	HugePagesFree     uint64            `json:"hugepages_free" meminfo:"HugePages_Free"`              // Number of huge pages in the pool that are not yet allocated.
+	HugePagesRsvd     uint64            `json:"hugepages_rsvd" meminfo:"HugePages_Rsvd"`              // (since Linux 2.6.17) Number of reserved but not yet allocated huge pages.
+	HugePagesSurp     uint64            `json:"hugepages_surp" meminfo:"HugePages_Surp"`              // (since Linux 2.6.24) Number of surplus huge pages.
+	Hugepagesize      uint64            `json:"hugepagesize_bytes" meminfo:"Hugepagesize"`            // Default huge page size.
+	Hugetlb           uint64            `json:"hugetlb_bytes" meminfo:"Hugetlb"`                      // (since Linux 4.16) Memory consumed by huge pages of all sizes.
+	DirectMap4k       uint64            `json:"direct_map_4k_bytes" meminfo:"DirectMap4k"`            // (since Linux 2.6.27)
+	DirectMap4M       uint64            `json:"direct_map_4m_bytes" meminfo:"DirectMap4M"`            // (since Linux 2.6.27)
+	DirectMap2M       uint64            `json:"direct_map_2m_bytes" meminfo:"DirectMap2M"`            // (since Linux 2.6.27)
+	DirectMap1G       uint64            `json:"direct_map_1g_bytes" meminfo:"DirectMap1G"`            // (since Linux 2.6.27)
+	Other             map[string]uint64 `json:"other,omitempty"`                                      // Lines of /proc/meminfo without a typed field.
+}
+
+// Cache returns the memory used by buffers, the page cache and reclaimable
+// slab. This matches the buff/cache column of free(1).
+func (m MemInfoDetail) Cache() uint64 {
+	return m.Buffers + m.Cached + m.SReclaimable
+}
+
+// UsedExcludingCache returns the memory in use by processes and the kernel,
+// not counting memory that can be reclaimed from caches. This matches the
+// used column of free(1), unlike HostMemoryInfo.Used which is Total - Free.
+func (m MemInfoDetail) UsedExcludingCache() uint64 {
+	switch {
+	case m.MemFree+m.Cache() < m.MemTotal:
+		return m.MemTotal - m.MemFree - m.Cache()
+	case m.MemFree < m.MemTotal:
+		// Same fallback as free(1) when the caches are over-reported.
+		return m.MemTotal - m.MemFree
+	default:
+		return 0
+	}
+}
+
+// NUMA is the interface that wraps the NUMA method.
+// NUMA returns the NUMA nodes of the host.
+type NUMA interface {
+	NUMA() ([]NUMANode, error)
+}
+
+// NUMANode contains the topology and memory usage of a NUMA node.
+type NUMANode struct {
+	ID        int            `json:"id"`
+	CPUs      []int          `json:"cpus"`             // CPUs that belong to the node.
+	Distances []int          `json:"distances"`        // Distance to each node, in ascending node ID order.
+	Memory    *MemInfoDetail `json:"memory,omitempty"` // Memory usage of the node.
+	Stat      *NUMAStat      `json:"stat,omitempty"`   // Page allocation counters of the node.
+}
+
+// NUMAStat contains the page allocation counters of a NUMA node.
+type NUMAStat struct {
+	NumaHit       uint64 `json:"numa_hit"`       // Pages allocated on this node as intended.
+	NumaMiss      uint64 `json:"numa_miss"`      // Pages allocated on this node despite preferring another node.
+	NumaForeign   uint64 `json:"numa_foreign"`   // Pages intended for this node but allocated on another node.
+	InterleaveHit uint64 `json:"interleave_hit"` // Interleaved pages allocated on this node as intended.
+	LocalNode     uint64 `json:"local_node"`     // Pages allocated on this node while the process was running on it.
+	OtherNode     uint64 `json:"other_node"`     // Pages allocated on this node while the process was running on another node.
+}
+
+// HugePages is the interface that wraps the HugePages method.
+// HugePages returns the huge page pools and transparent huge page settings.
+type HugePages interface {
+	HugePages() (*HugePagesInfo, error)
+}
+
+// HugePagesInfo contains the huge page configuration of a host.
+type HugePagesInfo struct {
+	Pools       []HugePagePool        `json:"pools"`                 // One pool per supported page size.
+	Transparent *TransparentHugePages `json:"transparent,omitempty"` // Transparent huge page settings.
+}
+
+// HugePagePool contains the counters of the huge page pool of one page size.
+// Counters are numbers of pages.
+type HugePagePool struct {
+	PageSize   uint64             `json:"page_size_bytes"`
+	Total      uint64             `json:"total"`           // Persistent huge pages in the pool.
+	Free       uint64             `json:"free"`            // Pages not yet allocated.
+	Reserved   uint64             `json:"reserved"`        // Pages committed for allocation but not yet allocated.
+	Surplus    uint64             `json:"surplus"`         // Pages above Total allocated through overcommit.
+	Overcommit uint64             `json:"overcommit"`      // Maximum number of surplus pages.
+	Nodes      []HugePageNodePool `json:"nodes,omitempty"` // Per NUMA node counters.
+}
+
+// HugePageNodePool contains the huge page counters of one NUMA node.
+type HugePageNodePool struct {
+	Node    int    `json:"node"`
+	Total   uint64 `json:"total"`
+	Free    uint64 `json:"free"`
+	Surplus uint64 `json:"surplus"`
+}
+
+// TransparentHugePages contains the selected transparent huge page modes.
+type TransparentHugePages struct {
+	Enabled string `json:"enabled"` // One of always, madvise or never.
+	Defrag  string `json:"defrag"`  // One of always, defer, defer+madvise, madvise or never.
+}
+
+// Swap is the interface that wraps the Swap method.
+// Swap returns the swap devices and compressed memory backends of the host.
+type Swap interface {
+	Swap() (*SwapInfo, error)
+}
+
+// SwapInfo contains the swap configuration and usage of a host.
+type SwapInfo struct {
+	Devices []SwapDevice `json:"devices"`         // Active swap areas.
+	ZRAM    []ZRAMDevice `json:"zram,omitempty"`  // Compressed RAM block devices.
+	Zswap   *ZswapInfo   `json:"zswap,omitempty"` // Compressed swap cache, if supported by the kernel.
+}
+
+// SwapDevice is an active swap area.
+type SwapDevice struct {
+	Path     string `json:"path"`
+	Type     string `json:"type"` // Either partition or file.
+	Size     uint64 `json:"size_bytes"`
+	Used     uint64 `json:"used_bytes"`
+	Priority int    `json:"priority"`
+	ZRAM     bool   `json:"zram"` // The swap area is backed by compressed memory rather than a disk.
+}
+
+// ZRAMDevice contains the stats of a zram block device.
+type ZRAMDevice struct {
+	Name           string `json:"name"`
+	DiskSize       uint64 `json:"disk_size_bytes"`      // Uncompressed capacity of the device.
+	Algorithm      string `json:"algorithm,omitempty"`  // Selected compression algorithm.
+	OrigDataSize   uint64 `json:"orig_data_bytes"`      // Uncompressed size of the stored data.
+	ComprDataSize  uint64 `json:"compr_data_bytes"`     // Compressed size of the stored data.
+	MemUsedTotal   uint64 `json:"mem_used_total_bytes"` // Memory consumed including allocator overhead.
+	MemLimit       uint64 `json:"mem_limit_bytes"`      // Maximum memory the device can consume (0 means no limit).
+	MemUsedMax     uint64 `json:"mem_used_max_bytes"`   // Maximum memory consumed so far.
+	SamePages      uint64 `json:"same_pages"`           // Pages filled with the same value, stored without memory.
+	PagesCompacted uint64 `json:"pages_compacted"`      // Pages freed by compaction.
+	HugePages      uint64 `json:"huge_pages"`           // Incompressible pages.
+}
+
+// ZswapInfo contains the configuration and usage of zswap.
+type ZswapInfo struct {
+	Enabled        bool              `json:"enabled"`
+	Compressor     string            `json:"compressor,omitempty"`
+	Zpool          string            `json:"zpool,omitempty"` // Removed in Linux 6.15.
+	MaxPoolPercent uint64            `json:"max_pool_percent"`
+	Stats          map[string]uint64 `json:"stats,omitempty"` // Counters from debugfs (e.g. pool_total_size, stored_pages). Only available when debugfs is readable.
+}
+
+// Interrupts is the interface that wraps the Interrupts method.
+// Interrupts returns interrupt, softirq and scheduler activity counters.
+type Interrupts interface {
+	Interrupts() (*InterruptsInfo, error)
+}
+
+// InterruptsInfo contains the interrupt counters from /proc/interrupts and
+// /proc/softirqs together with the activity counters from /proc/stat.
+type InterruptsInfo struct {
+	IRQs             []IRQ     `json:"irqs"`
+	SoftIRQs         []SoftIRQ `json:"softirqs"`
+	IRQTotal         uint64    `json:"irq_total"`         // Interrupts serviced since boot (intr).
+	SoftIRQTotal     uint64    `json:"softirq_total"`     // Softirqs serviced since boot (softirq).
+	ContextSwitches  uint64    `json:"context_switches"`  // Context switches since boot (ctxt).
+	ProcessesCreated uint64    `json:"processes_created"` // Forks since boot (processes).
+	ProcessesRunning uint64    `json:"processes_running"` // Runnable threads (procs_running).
+	ProcessesBlocked uint64    `json:"processes_blocked"` // Threads blocked waiting for I/O (procs_blocked).
+}
+
+// IRQ contains the per-CPU counters of an interrupt line.
+type IRQ struct {
+	Name        string   `json:"name"`                  // IRQ number, or the name of an architecture specific interrupt (e.g. NMI, LOC).
+	PerCPU      []uint64 `json:"per_cpu"`               // Counts for each online CPU. Some interrupts report a single system-wide count.
+	Chip        string   `json:"chip,omitempty"`        // Interrupt controller (numbered IRQs only).
+	HWIRQ       string   `json:"hwirq,omitempty"`       // Hardware interrupt number within the controller (numbered IRQs only).
+	Trigger     string   `json:"trigger,omitempty"`     // Trigger type (e.g. edge, level, fasteoi) when reported.
+	Devices     []string `json:"devices,omitempty"`     // Devices (actions) registered on the IRQ.
+	Description string   `json:"description,omitempty"` // Description of architecture specific interrupts.
+}
+
+// Total returns the sum of the per-CPU counts.
+func (i IRQ) Total() uint64 {
+	var total uint64
+	for _, v := range i.PerCPU {
+		total += v
+	}
+	return total
+}
+
+// SoftIRQ contains the per-CPU counters of a softirq type.
+type SoftIRQ struct {
+	Name   string   `json:"name"` // Softirq type (e.g. TIMER, NET_RX).
+	PerCPU []uint64 `json:"per_cpu"`
+}
+
+// SecurityPosture is the interface that wraps the SecurityPosture method.
+// SecurityPosture returns the status of the kernel security features.
+type SecurityPosture interface {
+	SecurityPosture() (*SecurityPostureInfo, error)
+}
+
+// SecurityPostureInfo contains the status of the Linux security modules and
+// kernel hardening features. Features that cannot be determined (e.g. because
+// securityfs is not mounted or not readable) are left empty.
+type SecurityPostureInfo struct {
+	LSMs               []string          `json:"lsms,omitempty"`                // Active Linux security modules in initialization order.
+	SELinux            *SELinuxInfo      `json:"selinux,omitempty"`             // Nil if selinuxfs is not mounted.
+	AppArmor           *AppArmorInfo     `json:"apparmor,omitempty"`            // Nil if the AppArmor module is not loaded.
+	Lockdown           string            `json:"lockdown,omitempty"`            // Kernel lockdown mode (none, integrity or confidentiality).
+	Tainted            uint64            `json:"tainted"`                       // Raw kernel taint bitmask.
+	TaintFlags         []string          `json:"taint_flags,omitempty"`         // Decoded taint flags (e.g. proprietary_module, oot_module).
+	SecureBoot         *bool             `json:"secure_boot,omitempty"`         // Nil if the system did not boot with UEFI.
+	CPUVulnerabilities map[string]string `json:"cpu_vulnerabilities,omitempty"` // Mitigation status by vulnerability name.
+}
+
+// SELinuxInfo contains the SELinux status.
+type SELinuxInfo struct {
+	Mode          string `json:"mode"`                     // enforcing or permissive.
+	PolicyType    string `json:"policy_type,omitempty"`    // Policy name from /etc/selinux/config (e.g. targeted).
+	PolicyVersion int    `json:"policy_version,omitempty"` // Version of the loaded policy format.
+	MLS           bool   `json:"mls"`                      // Multi-level security is enabled in the policy.
+}
+
+// AppArmorInfo contains the AppArmor status.
+type AppArmorInfo struct {
+	Enabled  bool           `json:"enabled"`
+	Profiles map[string]int `json:"profiles,omitempty"` // Number of loaded profiles by mode (e.g. enforce, complain). Requires privileges.
+}
+
 // VMStatInfo contains parsed info from /proc/vmstat.
 // This procfs file has expanded much over the years
 // with different kernel versions. If we don't have a field in vmstat,
@@ -17,7 +17,13 @@
 
 package types
 
-import "time"
+import (
+	"errors"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
 
 // Process is the main wrapper for gathering information on a process
 type Process interface {
@@ -75,6 +81,110 @@ type UserInfo struct {
 	SGID string `json:"sgid"`
 }
 
+// EntityKeyer is the interface that wraps the EntityKey method.
+// EntityKey returns a key that uniquely identifies a process, even after
+// its PID has been reused.
+type EntityKeyer interface {
+	EntityKey() (ProcessEntityKey, error)
+}
+
+// ProcessEntityKey uniquely identifies a process across PID reuse. Two
+// processes with the same PID differ in their start time, and start times
+// are only comparable within the same boot.
+type ProcessEntityKey struct {
+	PID        int    `json:"pid"`
+	StartTicks uint64 `json:"start_ticks"`       // Start time in clock ticks since boot.
+	BootID     string `json:"boot_id,omitempty"` // Empty if the boot ID is not available.
+}
+
+// String returns the key in the format <boot_id>/<pid>/<start_ticks>.
+func (k ProcessEntityKey) String() string {
+	return k.BootID + "/" + strconv.Itoa(k.PID) + "/" + strconv.FormatUint(k.StartTicks, 10)
+}
+
+// Lineage is the interface that wraps the Lineage method.
+// Lineage returns the entity key and the parent PID of a process. Both are
+// read at once, which makes building a ProcessTree cheaper than reading the
+// Status and EntityKey of each process.
+type Lineage interface {
+	Lineage() (ProcessLineage, error)
+}
+
+// ProcessLineage contains the entity key and the parent PID of a process.
+type ProcessLineage struct {
+	Key  ProcessEntityKey `json:"key"`
+	PPID int              `json:"ppid"`
+}
+
+// Status is the interface that wraps the Status method.
+// Status returns the parsed contents of /proc/[pid]/status on Linux.
+type Status interface {
+	Status() (*ProcessStatus, error)
+}
+
+// ProcessStatus contains the contents of /proc/[pid]/status. Memory values
+// are specified in bytes. Fields that are not reported by the running kernel
+// are left empty.
+type ProcessStatus struct {
+	Name      string   `json:"name"`
+	Umask     string   `json:"umask,omitempty"` // (since Linux 4.7)
+	State     string   `json:"state"`           // State code (e.g. R, S, D, Z).
+	StateName string   `json:"state_name"`      // State description (e.g. running, sleeping).
+	Tgid      int      `json:"tgid"`            // Thread group ID (the PID of the process).
+	Ngid      int      `json:"ngid"`            // NUMA group ID (since Linux 3.13).
+	PID       int      `json:"pid"`             // Thread ID.
+	PPID      int      `json:"ppid"`
+	TracerPID int      `json:"tracer_pid"` // PID of the process tracing this process, 0 if not traced.
+	User      UserInfo `json:"user"`
+	FDSize    int      `json:"fd_size"`          // Number of allocated file descriptor slots.
+	Groups    []string `json:"groups,omitempty"` // Supplementary group IDs.
+
+	// IDs in each of the PID namespaces of the process, from the outermost
+	// to the innermost namespace (since Linux 4.1).
+	NSTgid []int `json:"ns_tgid,omitempty"`
+	NSPid  []int `json:"ns_pid,omitempty"`
+	NSPgid []int `json:"ns_pgid,omitempty"`
+	NSSid  []int `json:"ns_sid,omitempty"`
+
+	VmPeak       uint64 `json:"vm_peak_bytes"`   // Peak virtual memory size.
+	VmSize       uint64 `json:"vm_size_bytes"`   // Virtual memory size.
+	VmLck        uint64 `json:"vm_lck_bytes"`    // Locked memory size.
+	VmPin        uint64 `json:"vm_pin_bytes"`    // Pinned memory size (since Linux 3.2).
+	VmHWM        uint64 `json:"vm_hwm_bytes"`    // Peak resident set size ("high water mark").
+	VmRSS        uint64 `json:"vm_rss_bytes"`    // Resident set size (RssAnon + RssFile + RssShmem).
+	RssAnon      uint64 `json:"rss_anon_bytes"`  // Resident anonymous memory (since Linux 4.5).
+	RssFile      uint64 `json:"rss_file_bytes"`  // Resident file mappings (since Linux 4.5).
+	RssShmem     uint64 `json:"rss_shmem_bytes"` // Resident shared memory (since Linux 4.5).
+	VmData       uint64 `json:"vm_data_bytes"`
+	VmStk        uint64 `json:"vm_stk_bytes"`
+	VmExe        uint64 `json:"vm_exe_bytes"`
+	VmLib        uint64 `json:"vm_lib_bytes"`
+	VmPTE        uint64 `json:"vm_pte_bytes"`        // Page table entries size (since Linux 2.6.10).
+	VmSwap       uint64 `json:"vm_swap_bytes"`       // Swapped-out anonymous memory (since Linux 2.6.34).
+	HugetlbPages uint64 `json:"hugetlb_pages_bytes"` // Size of hugetlb memory portions (since Linux 4.4).
+
+	Threads       int    `json:"threads"`
+	SigQueued     uint64 `json:"sig_queued"`      // Number of signals queued for the real user ID.
+	SigQueueLimit uint64 `json:"sig_queue_limit"` // Resource limit on the number of queued signals.
+
+	// Signal masks. Bit N is set for signal number N+1.
+	SigPending       uint64 `json:"sig_pending"`        // Pending for the thread.
+	SharedSigPending uint64 `json:"shared_sig_pending"` // Pending for the process as a whole.
+	SigBlocked       uint64 `json:"sig_blocked"`
+	SigIgnored       uint64 `json:"sig_ignored"`
+	SigCaught        uint64 `json:"sig_caught"`
+
+	Capabilities   CapabilityInfo `json:"capabilities"`
+	Seccomp        SeccompInfo    `json:"seccomp"`
+	SeccompFilters int            `json:"seccomp_filters"` // Number of attached seccomp filters (since Linux 5.9).
+
+	CPUsAllowed []int `json:"cpus_allowed,omitempty"` // CPUs on which the process may run.
+	MemsAllowed []int `json:"mems_allowed,omitempty"` // NUMA nodes from which the process may allocate memory.
+
+	VoluntaryCtxtSwitches    uint64 `json:"voluntary_ctxt_switches"`
+	NonvoluntaryCtxtSwitches uint64 `json:"nonvoluntary_ctxt_switches"`
+}
+
 // Environment is the interface that wraps the Environment method.
 // Environment returns variables for a process
 type Environment interface {
@@ -158,3 +268,414 @@ type Capabilities interface {
 type Seccomp interface {
 	Seccomp() (*SeccompInfo, error)
 }
+
+// NUMAMaps is the interface that wraps the NUMAMaps method.
+// NUMAMaps returns the memory of a process summarized per NUMA node.
+type NUMAMaps interface {
+	NUMAMaps() ([]NUMANodeMemory, error)
+}
+
+// NUMANodeMemory contains the memory of a process that resides on a NUMA node.
+type NUMANodeMemory struct {
+	Node  int    `json:"node"`
+	Pages uint64 `json:"pages"` // Number of pages, of any page size.
+	Bytes uint64 `json:"bytes"` // Sum of the pages multiplied by their page size.
+}
+
+// IOCounters is the interface that wraps the IOCounters method.
+// IOCounters returns the I/O accounting counters of a process. Reading the
+// counters of another user's process requires ptrace access to it.
+type IOCounters interface {
+	IOCounters() (*IOCountersInfo, error)
+}
+
+// IOCountersInfo contains the I/O accounting counters of a process.
+type IOCountersInfo struct {
+	ReadChars           uint64 `json:"read_chars"`            // Bytes passed to read-like syscalls, including cache hits.
+	WriteChars          uint64 `json:"write_chars"`           // Bytes passed to write-like syscalls.
+	ReadSyscalls        uint64 `json:"read_syscalls"`         // Number of read-like syscalls.
+	WriteSyscalls       uint64 `json:"write_syscalls"`        // Number of write-like syscalls.
+	ReadBytes           uint64 `json:"read_bytes"`            // Bytes fetched from the storage layer.
+	WriteBytes          uint64 `json:"write_bytes"`           // Bytes sent to the storage layer.
+	CancelledWriteBytes uint64 `json:"cancelled_write_bytes"` // Bytes whose writeback was cancelled (e.g. by truncation).
+}
+
+// SmapsMetrics is the interface that wraps the SmapsMetrics method.
+// SmapsMetrics returns the memory of a process in bytes summed from its
+// memory mappings, keyed by metric name (pss, pss_anon, pss_file,
+// pss_shmem, shared_clean, shared_dirty, private_clean, private_dirty, swap,
+// swap_pss and uss). On Linux, Memory reports the same values in
+// MemoryInfo.Metrics when they can be read, while SmapsMetrics returns the
+// error that prevented reading them.
+type SmapsMetrics interface {
+	SmapsMetrics() (map[string]uint64, error)
+}
+
+// MemoryMaps is the interface that wraps the MemoryMaps method.
+// MemoryMaps returns the memory regions mapped by a process.
+type MemoryMaps interface {
+	MemoryMaps() ([]MemoryMap, error)
+}
+
+// Kinds of memory regions reported in MemoryMap.Kind.
+const (
+	MemoryMapHeap  = "heap"  // The process heap.
+	MemoryMapStack = "stack" // The stack of the main thread.
+	MemoryMapVDSO  = "vdso"  // Kernel provided pages (vdso, vvar, vsyscall).
+	MemoryMapAnon  = "anon"  // Anonymous memory not backed by a file.
+	MemoryMapFile  = "file"  // Memory backed by a file.
+	MemoryMapMemFD = "memfd" // Memory backed by an anonymous file created with memfd_create.
+)
+
+// MemoryMap is a contiguous region of the virtual address space of a process.
+type MemoryMap struct {
+	StartAddress uint64 `json:"start_address"`
+	EndAddress   uint64 `json:"end_address"`
+	Perms        string `json:"perms"`          // Permissions (e.g. r-xp), p is private and s is shared.
+	Offset       uint64 `json:"offset"`         // Offset into the mapped file.
+	Device       string `json:"device"`         // Device of the mapped file as major:minor in hex.
+	Inode        uint64 `json:"inode"`          // Inode of the mapped file, 0 for anonymous memory.
+	Path         string `json:"path,omitempty"` // Mapped file or pseudo-path (e.g. [heap]).
+	Kind         string `json:"kind"`           // One of the MemoryMap* kinds.
+}
+
+// Size returns the size of the region in bytes.
+func (m MemoryMap) Size() uint64 {
+	return m.EndAddress - m.StartAddress
+}
+
+// SharedObjects returns the distinct paths of the shared objects (files with
+// a .so or .so.* name) mapped into memory, in order of their first mapping.
+// Shared objects loaded from memfd files are included.
+func SharedObjects(maps []MemoryMap) []string {
+	var (
+		objects []string
+		seen    = map[string]struct{}{}
+	)
+	for _, m := range maps {
+		if (m.Kind != MemoryMapFile && m.Kind != MemoryMapMemFD) || !isSharedObject(m.Path) {
+			continue
+		}
+		if _, found := seen[m.Path]; found {
+			continue
+		}
+		seen[m.Path] = struct{}{}
+		objects = append(objects, m.Path)
+	}
+	return objects
+}
+
+func isSharedObject(path string) bool {
+	name := path[strings.LastIndexByte(path, '/')+1:]
+	name = strings.TrimSuffix(name, " (deleted)")
+	return strings.HasSuffix(name, ".so") || strings.Contains(name, ".so.")
+}
+
+// Threads is the interface that wraps the Threads method.
+// Threads returns the threads of a process.
+type Threads interface {
+	Threads() ([]ThreadInfo, error)
+}
+
+// ThreadInfo contains information about a single thread of a process.
+type ThreadInfo struct {
+	TID                      int      `json:"tid"`
+	Name                     string   `json:"name"`  // Thread name (comm).
+	State                    string   `json:"state"` // State code (e.g. R, S, D).
+	CPU                      CPUTimes `json:"cpu"`
+	LastCPU                  int      `json:"last_cpu"` // CPU the thread last ran on.
+	VoluntaryCtxtSwitches    uint64   `json:"voluntary_ctxt_switches"`
+	NonvoluntaryCtxtSwitches uint64   `json:"nonvoluntary_ctxt_switches"`
+	Policy                   string   `json:"policy"`      // Scheduling policy (e.g. normal, fifo, rr).
+	Priority                 int      `json:"priority"`    // Kernel scheduling priority.
+	Nice                     int      `json:"nice"`        // Nice value (-20 to 19).
+	RTPriority               int      `json:"rt_priority"` // Real-time priority, 0 for non real-time policies.
+}
+
+// Namespaces is the interface that wraps the Namespaces method.
+// Namespaces returns the namespaces that a process is a member of.
+type Namespaces interface {
+	Namespaces() (NamespaceInodes, error)
+}
+
+// NamespaceInodes maps the namespace names of a process (e.g. mnt, net,
+// pid_for_children) to the inode numbers of the namespaces. Processes that
+// share a namespace report the same inode number.
+type NamespaceInodes map[string]uint64
+
+// Differs returns the sorted names of the namespaces whose inode differs
+// from the one in other. Namespaces that are only present in one of the two
+// are ignored. Comparing the namespaces of a process against those of PID 1
+// reports whether the process runs in a container.
+func (ns NamespaceInodes) Differs(other NamespaceInodes) []string {
+	var names []string
+	for name, inode := range ns {
+		if otherInode, found := other[name]; found && inode != otherInode {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// IsolatedNamespaces is the interface that wraps the IsolatedNamespaces method.
+// IsolatedNamespaces returns the sorted names of the namespaces of a process
+// that differ from those of PID 1. A process with a different mnt, pid or
+// net namespace typically runs in a container.
+type IsolatedNamespaces interface {
+	IsolatedNamespaces() ([]string, error)
+}
+
+// ResourceLimits is the interface that wraps the ResourceLimits method.
+// ResourceLimits returns the resource limits (rlimits) of a process.
+type ResourceLimits interface {
+	ResourceLimits() (*ResourceLimitsInfo, error)
+}
+
+// ResourceLimit contains the soft and hard value of a single resource limit.
+// The values are zero if the limit is unlimited.
+type ResourceLimit struct {
+	Soft          uint64 `json:"soft"`
+	Hard          uint64 `json:"hard"`
+	SoftUnlimited bool   `json:"soft_unlimited,omitempty"`
+	HardUnlimited bool   `json:"hard_unlimited,omitempty"`
+}
+
+// IsSoftUnlimited returns true if the soft limit is unlimited.
+func (l ResourceLimit) IsSoftUnlimited() bool {
+	return l.SoftUnlimited
+}
+
+// IsHardUnlimited returns true if the hard limit is unlimited.
+func (l ResourceLimit) IsHardUnlimited() bool {
+	return l.HardUnlimited
+}
+
+// ResourceLimitsInfo contains the resource limits of a process. Limits that
+// are not reported by the running kernel are nil.
+type ResourceLimitsInfo struct {
+	CPU        *ResourceLimit `json:"cpu,omitempty"`         // RLIMIT_CPU in seconds.
+	FileSize   *ResourceLimit `json:"file_size,omitempty"`   // RLIMIT_FSIZE in bytes.
+	Data       *ResourceLimit `json:"data,omitempty"`        // RLIMIT_DATA in bytes.
+	Stack      *ResourceLimit `json:"stack,omitempty"`       // RLIMIT_STACK in bytes.
+	Core       *ResourceLimit `json:"core,omitempty"`        // RLIMIT_CORE in bytes.
+	RSS        *ResourceLimit `json:"rss,omitempty"`         // RLIMIT_RSS in bytes.
+	Processes  *ResourceLimit `json:"processes,omitempty"`   // RLIMIT_NPROC.
+	OpenFiles  *ResourceLimit `json:"open_files"`            // RLIMIT_NOFILE, always reported.
+	MemLock    *ResourceLimit `json:"mem_lock,omitempty"`    // RLIMIT_MEMLOCK in bytes.
+	AddrSpace  *ResourceLimit `json:"addr_space,omitempty"`  // RLIMIT_AS in bytes.
+	FileLocks  *ResourceLimit `json:"file_locks,omitempty"`  // RLIMIT_LOCKS.
+	SigPending *ResourceLimit `json:"sig_pending,omitempty"` // RLIMIT_SIGPENDING.
+	MsgQueue   *ResourceLimit `json:"msg_queue,omitempty"`   // RLIMIT_MSGQUEUE in bytes.
+	Nice       *ResourceLimit `json:"nice,omitempty"`        // RLIMIT_NICE as 20 - nice value.
+	RTPriority *ResourceLimit `json:"rt_priority,omitempty"` // RLIMIT_RTPRIO.
+	RTTime     *ResourceLimit `json:"rt_time,omitempty"`     // RLIMIT_RTTIME in microseconds.
+}
+
+// OpenFilesUsage returns the number of open file descriptors of a process as
+// a fraction of its soft RLIMIT_NOFILE limit. A value close to 1 indicates
+// that the process is about to run out of file descriptors. The usage is 0
+// when the limit is unlimited. ErrNotImplemented is returned if the process
+// does not implement both OpenHandleCounter and ResourceLimits.
+func OpenFilesUsage(p Process) (float64, error) {
+	counter, ok := p.(OpenHandleCounter)
+	if !ok {
+		return 0, ErrNotImplemented
+	}
+	limiter, ok := p.(ResourceLimits)
+	if !ok {
+		return 0, ErrNotImplemented
+	}
+
+	limits, err := limiter.ResourceLimits()
+	if err != nil {
+		return 0, err
+	}
+	count, err := counter.OpenHandleCount()
+	if err != nil {
+		return 0, err
+	}
+
+	if limits.OpenFiles == nil {
+		return 0, errors.New("open files limit is not reported")
+	}
+	if limits.OpenFiles.IsSoftUnlimited() || limits.OpenFiles.Soft == 0 {
+		return 0, nil
+	}
+	return float64(count) / float64(limits.OpenFiles.Soft), nil
+}
+
+// Scheduling is the interface that wraps the Scheduling method.
+// Scheduling returns the state and scheduling attributes of a process.
+type Scheduling interface {
+	Scheduling() (*SchedulingInfo, error)
+}
+
+// SchedulingInfo contains the state and scheduling attributes of a process.
+type SchedulingInfo struct {
+	State       string      `json:"state"`                 // State code (e.g. R, S, D, Z).
+	Priority    int         `json:"priority"`              // Kernel scheduling priority.
+	Nice        int         `json:"nice"`                  // Nice value (-20 to 19).
+	NumThreads  int         `json:"num_threads"`           // Number of threads.
+	LastCPU     int         `json:"last_cpu"`              // CPU the process last ran on.
+	RTPriority  int         `json:"rt_priority"`           // Real-time priority, 0 for non real-time policies.
+	Policy      string      `json:"policy"`                // Scheduling policy (e.g. normal, fifo, rr).
+	CPUAffinity []int       `json:"cpu_affinity"`          // CPUs on which the process may run.
+	IOPriority  *IOPriority `json:"io_priority,omitempty"` // Nil if the I/O priority cannot be queried.
+	Autogroup   *Autogroup  `json:"autogroup,omitempty"`   // Nil if autogroup scheduling is not supported.
+}
+
+// I/O scheduling classes reported in IOPriority.Class.
+const (
+	IOPriorityClassNone       = "none" // No class set, derived from the nice value.
+	IOPriorityClassRealtime   = "realtime"
+	IOPriorityClassBestEffort = "best-effort"
+	IOPriorityClassIdle       = "idle"
+)
+
+// IOPriority is the I/O scheduling class and priority level of a process.
+type IOPriority struct {
+	Class string `json:"class"`
+	Level int    `json:"level"` // Priority within the class, 0 (highest) to 7 (lowest).
+}
+
+// Autogroup is the scheduling autogroup that a process belongs to.
+type Autogroup struct {
+	ID   int `json:"id"`
+	Nice int `json:"nice"` // Nice value applied to the group as a whole.
+}
+
+// Children is the interface that wraps the Children method.
+// Children returns the direct child processes of a process.
+type Children interface {
+	Children() ([]Process, error)
+}
+
+// Executable is the interface that wraps the Executable method.
+// Executable returns information about the executable file of a process.
+type Executable interface {
+	Executable() (*ExecutableInfo, error)
+}
+
+// ExecutableInfo describes the executable file that a process runs. The
+// information is read from the file that the process has mapped, which may
+// differ from the file that is currently present at Path.
+type ExecutableInfo struct {
+	Path    string    `json:"path"`               // Path without the " (deleted)" suffix.
+	Size    int64     `json:"size_bytes"`         // File size.
+	Device  uint64    `json:"device"`             // Device number of the filesystem.
+	Inode   uint64    `json:"inode"`              // Inode number.
+	ModTime time.Time `json:"mod_time"`           // Last modification time.
+	UID     string    `json:"uid"`                // Owner user ID.
+	GID     string    `json:"gid"`                // Owner group ID.
+	SHA256  string    `json:"sha256,omitempty"`   // Hex encoded hash, empty if the file exceeds the size limit.
+	BuildID string    `json:"build_id,omitempty"` // Hex encoded ELF build ID (NT_GNU_BUILD_ID).
+	Deleted bool      `json:"deleted"`            // The file was removed or replaced on disk.
+	MemFD   bool      `json:"memfd"`              // The file is anonymous memory (e.g. memfd_create) and never existed on disk.
+}
+
+// GoBuild is the interface that wraps the GoBuild method.
+// GoBuild returns the build information embedded in the executable of a Go
+// process. It returns an error wrapping ErrNotGoExecutable for processes
+// that are not Go programs.
+type GoBuild interface {
+	GoBuild() (*GoBuildInfo, error)
+}
+
+// GoBuildInfo contains the build information of a Go executable.
+type GoBuildInfo struct {
+	GoVersion string           `json:"go_version"`         // Version of the Go toolchain (e.g. go1.22.3).
+	Path      string           `json:"path"`               // Package path of the main package.
+	Main      GoModule         `json:"main"`               // Module containing the main package.
+	Deps      []GoModule       `json:"deps,omitempty"`     // Module dependencies.
+	Settings  []GoBuildSetting `json:"settings,omitempty"` // All build settings in the order they were recorded.
+
+	// Commonly used build settings.
+	CGOEnabled  bool   `json:"cgo_enabled"`            // CGO_ENABLED=1.
+	TrimPath    bool   `json:"trimpath"`               // Built with -trimpath.
+	VCSRevision string `json:"vcs_revision,omitempty"` // Revision of the source tree.
+	VCSTime     string `json:"vcs_time,omitempty"`     // Commit time of the revision (RFC 3339).
+	VCSModified bool   `json:"vcs_modified"`           // The source tree had local modifications.
+}
+
+// GoModule is a module that is part of a Go executable.
+type GoModule struct {
+	Path    string    `json:"path"`
+	Version string    `json:"version"`
+	Sum     string    `json:"sum,omitempty"`
+	Replace *GoModule `json:"replace,omitempty"` // Module that replaced this one.
+}
+
+// GoBuildSetting is a key-value pair describing one setting that influenced
+// the build (e.g. -ldflags, GOARCH, vcs.revision).
+type GoBuildSetting struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+// Container is the interface that wraps the Container method.
+// Container returns the container and Kubernetes pod that a process belongs
+// to. It returns nil if the process does not run in a recognized container.
+type Container interface {
+	Container() (*ContainerInfo, error)
+}
+
+// Container runtimes reported in ContainerInfo.Runtime.
+const (
+	ContainerRuntimeDocker     = "docker"
+	ContainerRuntimeContainerd = "containerd"
+	ContainerRuntimeCRIO       = "cri-o"
+	ContainerRuntimePodman     = "podman"
+	ContainerRuntimeNspawn     = "systemd-nspawn"
+)
+
+// Kubernetes pod QoS classes reported in ContainerInfo.QoSClass.
+const (
+	PodQoSGuaranteed = "guaranteed"
+	PodQoSBurstable  = "burstable"
+	PodQoSBestEffort = "besteffort"
+)
+
+// ContainerInfo identifies the container and Kubernetes pod of a process.
+type ContainerInfo struct {
+	Runtime  string `json:"runtime,omitempty"`   // Empty if the runtime cannot be determined from the cgroup path.
+	ID       string `json:"id,omitempty"`        // Container ID, or machine name for systemd-nspawn.
+	PodUID   string `json:"pod_uid,omitempty"`   // UID of the Kubernetes pod.
+	QoSClass string `json:"qos_class,omitempty"` // QoS class of the Kubernetes pod.
+	Cgroup   string `json:"cgroup"`              // Cgroup path that the information was derived from.
+}
+
+// OOM is the interface that wraps the OOM method.
+// OOM returns the out-of-memory killer score of a process.
+type OOM interface {
+	OOM() (*OOMInfo, error)
+}
+
+// OOMInfo contains the out-of-memory killer score of a process.
+type OOMInfo struct {
+	Score    int `json:"score"`     // Badness score, the process with the highest score is killed first.
+	ScoreAdj int `json:"score_adj"` // Adjustment added to the score (-1000 to 1000, -1000 disables OOM killing).
+}
+
+// CoreDump is the interface that wraps the CoreDump method.
+// CoreDump returns the core dump settings of a process.
+type CoreDump interface {
+	CoreDump() (*CoreDumpInfo, error)
+}
+
+// CoreDumpInfo contains the core dump settings of a process.
+type CoreDumpInfo struct {
+	Filter   uint64    `json:"filter"`             // Bitmask of the memory mapping types written to core dumps.
+	Dumpable *Dumpable `json:"dumpable,omitempty"` // Nil if the dumpable setting cannot be determined.
+}
+
+// Dumpable is the dumpable setting of a process, see PR_SET_DUMPABLE in
+// prctl(2) and /proc/sys/fs/suid_dumpable in proc(5).
+type Dumpable int
+
+// Dumpable settings reported in CoreDumpInfo.Dumpable.
+const (
+	DumpableDisabled Dumpable = 0 // No core dumps (SUID_DUMP_DISABLE).
+	DumpableUser     Dumpable = 1 // Core dumps owned by the user of the process (SUID_DUMP_USER).
+	DumpableRoot     Dumpable = 2 // Core dumps only readable by root (SUID_DUMP_ROOT).
+)
@@ -0,0 +1,59 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package types
+
+import "sort"
+
+// OOMCandidate is a process ranked by its out-of-memory killer score.
+type OOMCandidate struct {
+	Process Process `json:"-"`
+	PID     int     `json:"pid"`
+	OOM     OOMInfo `json:"oom"`
+}
+
+// TopOOMScores returns up to n of the given processes in the order in which
+// the OOM killer would select them, that is by descending score. All
+// processes are returned if n is not positive. Processes that do not
+// implement OOM or whose score cannot be read (e.g. because they exited) are
+// omitted.
+func TopOOMScores(processes []Process, n int) []OOMCandidate {
+	candidates := make([]OOMCandidate, 0, len(processes))
+	for _, p := range processes {
+		o, ok := p.(OOM)
+		if !ok {
+			continue
+		}
+		info, err := o.OOM()
+		if err != nil {
+			continue
+		}
+		candidates = append(candidates, OOMCandidate{Process: p, PID: p.PID(), OOM: *info})
+	}
+
+	sort.Slice(candidates, func(i, j int) bool {
+		if candidates[i].OOM.Score != candidates[j].OOM.Score {
+			return candidates[i].OOM.Score > candidates[j].OOM.Score
+		}
+		return candidates[i].PID < candidates[j].PID
+	})
+
+	if n > 0 && len(candidates) > n {
+		candidates = candidates[:n]
+	}
+	return candidates
+}
@@ -0,0 +1,59 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package types
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeOOMProcess struct {
+	fakeProcess
+	score int
+	err   error
+}
+
+func (p fakeOOMProcess) OOM() (*OOMInfo, error) {
+	if p.err != nil {
+		return nil, p.err
+	}
+	return &OOMInfo{Score: p.score}, nil
+}
+
+func TestTopOOMScores(t *testing.T) {
+	processes := []Process{
+		fakeOOMProcess{fakeProcess: fakeProcess{pid: 1}, score: 0},
+		fakeOOMProcess{fakeProcess: fakeProcess{pid: 2}, score: 500},
+		fakeOOMProcess{fakeProcess: fakeProcess{pid: 3}, score: 900},
+		fakeOOMProcess{fakeProcess: fakeProcess{pid: 4}, score: 500},
+		fakeOOMProcess{fakeProcess: fakeProcess{pid: 5}, err: errors.New("exited")},
+		fakeProcess{pid: 6}, // Does not implement OOM.
+	}
+
+	pids := func(candidates []OOMCandidate) []int {
+		var pids []int
+		for _, c := range candidates {
+			pids = append(pids, c.PID)
+		}
+		return pids
+	}
+	assert.Equal(t, []int{3, 2, 4}, pids(TopOOMScores(processes, 3)))
+	assert.Equal(t, []int{3, 2, 4, 1}, pids(TopOOMScores(processes, 0)))
+}
@@ -0,0 +1,260 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package types
+
+import (
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// Language runtimes reported in RuntimeInfo.Runtime.
+const (
+	RuntimeJVM    = "jvm"
+	RuntimePython = "python"
+	RuntimeNode   = "nodejs"
+	RuntimeRuby   = "ruby"
+	RuntimeDotNet = "dotnet"
+	RuntimePHP    = "php"
+	RuntimePerl   = "perl"
+	RuntimeGo     = "go"
+)
+
+// RuntimeInfo describes the language runtime of a process.
+type RuntimeInfo struct {
+	Runtime     string `json:"runtime"`               // One of the Runtime* constants.
+	Version     string `json:"version,omitempty"`     // Runtime version if it can be determined (e.g. 3.12, go1.22.3).
+	Application string `json:"application,omitempty"` // Main class, jar, script, module or program that is run.
+}
+
+// DetectRuntime identifies the language runtime of a process from its
+// executable, its arguments, the shared objects it has mapped (if it
+// implements MemoryMaps) and its Go build information (if it implements
+// GoBuild). Instead of the name of the interpreter, the Application is the
+// program that the runtime executes, for example the main class of a JVM or
+// the script run by Python. It returns nil if the runtime is not recognized.
+func DetectRuntime(p Process) (*RuntimeInfo, error) {
+	info, err := p.Info()
+	if err != nil {
+		return nil, err
+	}
+
+	var libraries []string
+	if m, ok := p.(MemoryMaps); ok {
+		// Mappings are optional because reading them requires ptrace
+		// access to the process.
+		if maps, err := m.MemoryMaps(); err == nil {
+			libraries = SharedObjects(maps)
+		}
+	}
+
+	if rt := classifyRuntime(info.Exe, info.Args, libraries); rt != nil {
+		return rt, nil
+	}
+
+	if g, ok := p.(GoBuild); ok {
+		if build, err := g.GoBuild(); err == nil {
+			return &RuntimeInfo{Runtime: RuntimeGo, Version: build.GoVersion, Application: build.Path}, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// runtimeMatcher identifies a runtime by the name of its executable or the
+// name of a shared object that embeds it.
+type runtimeMatcher struct {
+	runtime string
+	exe     *regexp.Regexp // First subexpression, if any, is the version.
+	library *regexp.Regexp // First subexpression, if any, is the version.
+	args    runtimeArgs
+}
+
+var runtimeMatchers = []runtimeMatcher{
+	{
+		runtime: RuntimeJVM,
+		exe:     regexp.MustCompile(`^javaw?$`),
+		library: regexp.MustCompile(`^libjvm\.so$`),
+		args: runtimeArgs{
+			valueFlags: flagSet("-cp", "-classpath", "--class-path", "-p", "--module-path",
+				"--upgrade-module-path", "--add-modules", "--add-reads", "--add-exports",
+				"--add-opens", "--limit-modules", "--patch-module", "--enable-native-access"),
+			appFlags: flagSet("-jar", "-m", "--module"),
+		},
+	},
+	{
+		runtime: RuntimePython,
+		exe:     regexp.MustCompile(`^(?:python|pypy)(\d+(?:\.\d+)?)?$`),
+		library: regexp.MustCompile(`^libpython(\d+\.\d+)`),
+		args: runtimeArgs{
+			valueFlags: flagSet("-W", "-X", "--check-hash-based-pycs"),
+			appFlags:   flagSet("-m"),
+			stopFlags:  flagSet("-c"),
+		},
+	},
+	{
+		runtime: RuntimeNode,
+		exe:     regexp.MustCompile(`^node(?:js)?$`),
+		library: regexp.MustCompile(`^libnode\.so`),
+		args: runtimeArgs{
+			valueFlags: flagSet("-r", "--require", "--import", "--loader", "--experimental-loader", "--title"),
+			stopFlags:  flagSet("-e", "--eval", "-p", "--print"),
+		},
+	},
+	{
+		runtime: RuntimeRuby,
+		exe:     regexp.MustCompile(`^ruby(\d+(?:\.\d+)*)?$`),
+		library: regexp.MustCompile(`^libruby(?:-\w+)?\.so\.?(\d+\.\d+)?`),
+		args: runtimeArgs{
+			valueFlags: flagSet("-I", "-r", "-C"),
+			stopFlags:  flagSet("-e"),
+		},
+	},
+	{
+		runtime: RuntimeDotNet,
+		exe:     regexp.MustCompile(`^dotnet$`),
+		library: regexp.MustCompile(`^libcoreclr\.so$`),
+		args: runtimeArgs{
+			appSuffix: ".dll",
+		},
+	},
+	{
+		runtime: RuntimePHP,
+		exe:     regexp.MustCompile(`^php(?:-fpm|-cgi)?(\d+(?:\.\d+)*)?$`),
+		library: regexp.MustCompile(`^libphp(\d*)`),
+		args: runtimeArgs{
+			valueFlags: flagSet("-c", "-d", "-t", "-z"),
+			appFlags:   flagSet("-f"),
+			stopFlags:  flagSet("-r"),
+		},
+	},
+	{
+		runtime: RuntimePerl,
+		exe:     regexp.MustCompile(`^perl(5[\d.]*)?$`),
+		library: regexp.MustCompile(`^libperl\.so\.?(\d+\.\d+)?`),
+		args: runtimeArgs{
+			stopFlags: flagSet("-e", "-E"),
+		},
+	},
+}
+
+// classifyRuntime identifies the runtime of a process from the path of its
+// executable, its arguments (including argv[0]) and its shared objects.
+func classifyRuntime(exe string, args []string, libraries []string) *RuntimeInfo {
+	name := filepath.Base(strings.TrimSuffix(exe, " (deleted)"))
+	if exe == "" && len(args) > 0 {
+		name = filepath.Base(args[0])
+	}
+	var params []string
+	if len(args) > 1 {
+		params = args[1:]
+	}
+
+	for _, m := range runtimeMatchers {
+		if match := m.exe.FindStringSubmatch(name); match != nil {
+			return &RuntimeInfo{
+				Runtime:     m.runtime,
+				Version:     submatch(match),
+				Application: m.args.application(params),
+			}
+		}
+	}
+
+	// Runtimes embedded by another program (e.g. uWSGI embedding Python or
+	// an application host embedding the CLR) are identified by their
+	// shared objects. The program itself is the application.
+	for _, m := range runtimeMatchers {
+		for _, lib := range libraries {
+			if match := m.library.FindStringSubmatch(filepath.Base(lib)); match != nil {
+				return &RuntimeInfo{
+					Runtime:     m.runtime,
+					Version:     submatch(match),
+					Application: name,
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func submatch(match []string) string {
+	if len(match) > 1 {
+		return match[1]
+	}
+	return ""
+}
+
+// runtimeArgs describes the command line options of a runtime that are
+// needed to find the application among its arguments.
+type runtimeArgs struct {
+	valueFlags map[string]bool // Options followed by a separate value.
+	appFlags   map[string]bool // Options whose value is the application (e.g. -jar, -m).
+	stopFlags  map[string]bool // Options after which no application follows (e.g. -c, -e).
+	appSuffix  string          // If set, the application is the first argument with this suffix.
+}
+
+func flagSet(flags ...string) map[string]bool {
+	set := make(map[string]bool, len(flags))
+	for _, f := range flags {
+		set[f] = true
+	}
+	return set
+}
+
+// application returns the application from the arguments of a runtime. It
+// is the value of an application option or the first argument that is not
+// an option.
+func (r runtimeArgs) application(args []string) string {
+	if r.appSuffix != "" {
+		for _, a := range args {
+			if strings.HasSuffix(a, r.appSuffix) {
+				return a
+			}
+		}
+		return ""
+	}
+
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		flag, value, hasValue := strings.Cut(arg, "=")
+		switch {
+		case r.appFlags[arg]:
+			if i+1 < len(args) {
+				return args[i+1]
+			}
+			return ""
+		case hasValue && r.appFlags[flag]:
+			return value
+		case r.stopFlags[arg]:
+			return ""
+		case r.valueFlags[arg]:
+			i++
+		case arg == "--":
+			if i+1 < len(args) {
+				return args[i+1]
+			}
+			return ""
+		case strings.HasPrefix(arg, "-"):
+			// Option without a separate value.
+		default:
+			return arg
+		}
+	}
+	return ""
+}
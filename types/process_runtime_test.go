@@ -0,0 +1,131 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestClassifyRuntime(t *testing.T) {
+	tests := []struct {
+		name      string
+		exe       string
+		args      []string
+		libraries []string
+		want      *RuntimeInfo
+	}{
+		{
+			name: "java main class",
+			exe:  "/usr/lib/jvm/java-21-openjdk/bin/java",
+			args: []string{"java", "-Xmx2g", "-cp", "/opt/app/lib/*", "-Dlog.level=info", "com.example.Server", "--port", "8080"},
+			want: &RuntimeInfo{Runtime: RuntimeJVM, Application: "com.example.Server"},
+		},
+		{
+			name: "java jar",
+			exe:  "/usr/bin/java",
+			args: []string{"java", "-XX:+UseG1GC", "-jar", "/opt/kafka/kafka.jar", "config.properties"},
+			want: &RuntimeInfo{Runtime: RuntimeJVM, Application: "/opt/kafka/kafka.jar"},
+		},
+		{
+			name: "java module",
+			exe:  "/usr/bin/java",
+			args: []string{"java", "--module-path", "mods", "--module=com.example/com.example.Main"},
+			want: &RuntimeInfo{Runtime: RuntimeJVM, Application: "com.example/com.example.Main"},
+		},
+		{
+			name: "python script",
+			exe:  "/usr/bin/python3.12",
+			args: []string{"/usr/bin/python3", "-u", "-W", "ignore", "/opt/app/worker.py", "--queue", "default"},
+			want: &RuntimeInfo{Runtime: RuntimePython, Version: "3.12", Application: "/opt/app/worker.py"},
+		},
+		{
+			name: "python module",
+			exe:  "/usr/bin/python3.11",
+			args: []string{"python3", "-m", "gunicorn", "app:app"},
+			want: &RuntimeInfo{Runtime: RuntimePython, Version: "3.11", Application: "gunicorn"},
+		},
+		{
+			name: "python command",
+			exe:  "/usr/bin/python3",
+			args: []string{"python3", "-c", "import time; time.sleep(10)"},
+			want: &RuntimeInfo{Runtime: RuntimePython, Version: "3"},
+		},
+		{
+			name: "node",
+			exe:  "/usr/local/bin/node",
+			args: []string{"node", "--max-old-space-size=4096", "-r", "dotenv/config", "dist/server.js"},
+			want: &RuntimeInfo{Runtime: RuntimeNode, Application: "dist/server.js"},
+		},
+		{
+			name: "ruby",
+			exe:  "/usr/bin/ruby3.1",
+			args: []string{"ruby", "-I", "lib", "bin/rails", "server"},
+			want: &RuntimeInfo{Runtime: RuntimeRuby, Version: "3.1", Application: "bin/rails"},
+		},
+		{
+			name: "dotnet",
+			exe:  "/usr/share/dotnet/dotnet",
+			args: []string{"dotnet", "exec", "/app/Api.dll"},
+			want: &RuntimeInfo{Runtime: RuntimeDotNet, Application: "/app/Api.dll"},
+		},
+		{
+			name: "php",
+			exe:  "/usr/bin/php8.2",
+			args: []string{"php", "-d", "memory_limit=-1", "artisan", "queue:work"},
+			want: &RuntimeInfo{Runtime: RuntimePHP, Version: "8.2", Application: "artisan"},
+		},
+		{
+			name: "perl",
+			exe:  "/usr/bin/perl",
+			args: []string{"/usr/bin/perl", "-w", "/usr/sbin/munin-node"},
+			want: &RuntimeInfo{Runtime: RuntimePerl, Application: "/usr/sbin/munin-node"},
+		},
+		{
+			name: "deleted interpreter without exe",
+			args: []string{"/usr/bin/python3", "app.py"},
+			want: &RuntimeInfo{Runtime: RuntimePython, Version: "3", Application: "app.py"},
+		},
+		{
+			name:      "embedded python",
+			exe:       "/usr/bin/uwsgi",
+			args:      []string{"uwsgi", "--ini", "app.ini"},
+			libraries: []string{"/usr/lib/x86_64-linux-gnu/libc.so.6", "/usr/lib/x86_64-linux-gnu/libpython3.11.so.1.0"},
+			want:      &RuntimeInfo{Runtime: RuntimePython, Version: "3.11", Application: "uwsgi"},
+		},
+		{
+			name:      "self-contained .NET",
+			exe:       "/app/Api",
+			libraries: []string{"/app/libcoreclr.so"},
+			want:      &RuntimeInfo{Runtime: RuntimeDotNet, Application: "Api"},
+		},
+		{
+			name:      "unknown",
+			exe:       "/usr/sbin/sshd",
+			args:      []string{"sshd", "-D"},
+			libraries: []string{"/usr/lib/x86_64-linux-gnu/libc.so.6"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, classifyRuntime(tc.exe, tc.args, tc.libraries))
+		})
+	}
+}
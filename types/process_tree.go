@@ -0,0 +1,197 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package types
+
+import (
+	"sort"
+	"time"
+)
+
+// ProcessTree is a forest of processes linked by their parent PIDs.
+type ProcessTree struct {
+	Nodes map[int]*ProcessNode `json:"-"`     // All processes by PID.
+	Roots []*ProcessNode       `json:"roots"` // Processes without a known parent, sorted by PID.
+}
+
+// ProcessNode is a process within a ProcessTree.
+type ProcessNode struct {
+	Process  Process        `json:"-"`
+	PID      int            `json:"pid"`
+	PPID     int            `json:"ppid"`
+	Parent   *ProcessNode   `json:"-"`                  // Nil for roots.
+	Children []*ProcessNode `json:"children,omitempty"` // Sorted by PID.
+}
+
+// NewProcessTree builds a ProcessTree from a list of processes. The parent
+// PID and start time are read from the Lineage of processes that implement
+// it. Otherwise the parent PID is read from the Status or the Info, and the
+// start time from the EntityKey or the Info. Processes whose parent PID
+// cannot be read (e.g. because they exited) are omitted.
+//
+// A process whose parent PID belongs to a process that started after it is
+// turned into a root, because its original parent has exited and the PID was
+// reused (see ErrParentPIDReused).
+//
+// The parent PIDs are not read atomically, so a PID that was reused while
+// the processes were scanned can create a cycle. Such cycles are broken by
+// turning the process whose parent link closes the cycle into a root.
+func NewProcessTree(processes []Process) *ProcessTree {
+	tree := &ProcessTree{Nodes: make(map[int]*ProcessNode, len(processes))}
+	starts := make(map[int]processStart, len(processes))
+	for _, p := range processes {
+		ppid, start, err := readLineage(p)
+		if err != nil {
+			continue
+		}
+		tree.Nodes[p.PID()] = &ProcessNode{Process: p, PID: p.PID(), PPID: ppid}
+		starts[p.PID()] = start
+	}
+
+	pids := make([]int, 0, len(tree.Nodes))
+	for pid := range tree.Nodes {
+		pids = append(pids, pid)
+	}
+	sort.Ints(pids)
+
+	for _, pid := range pids {
+		node := tree.Nodes[pid]
+		parent, found := tree.Nodes[node.PPID]
+		if !found || parent == node || isAncestorOf(node, parent) || starts[parent.PID].after(starts[pid]) {
+			tree.Roots = append(tree.Roots, node)
+			continue
+		}
+		node.Parent = parent
+		parent.Children = append(parent.Children, node)
+	}
+
+	return tree
+}
+
+// isAncestorOf returns true if a is an ancestor of n or n itself.
+func isAncestorOf(a, n *ProcessNode) bool {
+	for ; n != nil; n = n.Parent {
+		if n == a {
+			return true
+		}
+	}
+	return false
+}
+
+// processStart is the start time of a process.
+type processStart struct {
+	ticks uint64    // Start time in clock ticks since boot, zero if unknown.
+	time  time.Time // Start time, zero if unknown.
+}
+
+// readLineage returns the parent PID and the start time of p.
+func readLineage(p Process) (int, processStart, error) {
+	if l, ok := p.(Lineage); ok {
+		if lineage, err := l.Lineage(); err == nil {
+			return lineage.PPID, processStart{ticks: lineage.Key.StartTicks}, nil
+		}
+	}
+
+	ppid, err := parentPID(p)
+	if err != nil {
+		return 0, processStart{}, err
+	}
+	return ppid, readProcessStart(p), nil
+}
+
+func readProcessStart(p Process) processStart {
+	if k, ok := p.(EntityKeyer); ok {
+		if key, err := k.EntityKey(); err == nil {
+			return processStart{ticks: key.StartTicks}
+		}
+	}
+	if info, err := p.Info(); err == nil {
+		return processStart{time: info.StartTime}
+	}
+	return processStart{}
+}
+
+// after returns true if s is known to be later than other.
+func (s processStart) after(other processStart) bool {
+	if s.ticks != 0 && other.ticks != 0 {
+		return s.ticks > other.ticks
+	}
+	if !s.time.IsZero() && !other.time.IsZero() {
+		return s.time.After(other.time)
+	}
+	return false
+}
+
+func parentPID(p Process) (int, error) {
+	if s, ok := p.(Status); ok {
+		status, err := s.Status()
+		if err == nil {
+			return status.PPID, nil
+		}
+	}
+
+	info, err := p.Info()
+	if err != nil {
+		return 0, err
+	}
+	return info.PPID, nil
+}
+
+// Ancestors returns the ancestors of the process with the given PID, from
+// its parent up to the root of its tree. It returns nil if the PID is not
+// part of the tree.
+func (t *ProcessTree) Ancestors(pid int) []*ProcessNode {
+	node, found := t.Nodes[pid]
+	if !found {
+		return nil
+	}
+
+	var ancestors []*ProcessNode
+	seen := map[*ProcessNode]struct{}{node: {}}
+	for n := node.Parent; n != nil; n = n.Parent {
+		if _, loop := seen[n]; loop {
+			break
+		}
+		seen[n] = struct{}{}
+		ancestors = append(ancestors, n)
+	}
+	return ancestors
+}
+
+// Descendants returns all descendants of the process with the given PID in
+// breadth-first order. It returns nil if the PID is not part of the tree.
+func (t *ProcessTree) Descendants(pid int) []*ProcessNode {
+	node, found := t.Nodes[pid]
+	if !found {
+		return nil
+	}
+
+	var descendants []*ProcessNode
+	seen := map[*ProcessNode]struct{}{node: {}}
+	queue := node.Children
+	for len(queue) > 0 {
+		n := queue[0]
+		queue = queue[1:]
+		if _, loop := seen[n]; loop {
+			continue
+		}
+		seen[n] = struct{}{}
+		descendants = append(descendants, n)
+		queue = append(queue, n.Children...)
+	}
+	return descendants
+}
@@ -0,0 +1,132 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package types
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type fakeProcess struct {
+	Process
+	pid, ppid int
+	start     time.Time
+	err       error
+}
+
+func (p fakeProcess) PID() int { return p.pid }
+
+func (p fakeProcess) Info() (ProcessInfo, error) {
+	return ProcessInfo{PID: p.pid, PPID: p.ppid, StartTime: p.start}, p.err
+}
+
+// lineageProcess is a process that implements Lineage. Its Info fails, so
+// the tree must be built from the lineage alone.
+type lineageProcess struct {
+	fakeProcess
+	startTicks uint64
+}
+
+func (p lineageProcess) Info() (ProcessInfo, error) {
+	return ProcessInfo{}, errors.New("info must not be read")
+}
+
+func (p lineageProcess) Lineage() (ProcessLineage, error) {
+	return ProcessLineage{
+		Key:  ProcessEntityKey{PID: p.pid, StartTicks: p.startTicks},
+		PPID: p.ppid,
+	}, nil
+}
+
+func nodePIDs(nodes []*ProcessNode) []int {
+	pids := make([]int, 0, len(nodes))
+	for _, n := range nodes {
+		pids = append(pids, n.PID)
+	}
+	return pids
+}
+
+func TestNewProcessTree(t *testing.T) {
+	tree := NewProcessTree([]Process{
+		fakeProcess{pid: 30, ppid: 10},
+		fakeProcess{pid: 1, ppid: 0},
+		fakeProcess{pid: 10, ppid: 1},
+		fakeProcess{pid: 20, ppid: 1},
+		fakeProcess{pid: 40, ppid: 30},
+		fakeProcess{pid: 50, ppid: 99},                      // Parent is not known.
+		fakeProcess{pid: 60, ppid: 1, err: errors.New("x")}, // Exited.
+	})
+
+	require.Len(t, tree.Nodes, 6)
+	assert.Equal(t, []int{1, 50}, nodePIDs(tree.Roots))
+	assert.Equal(t, []int{10, 20}, nodePIDs(tree.Nodes[1].Children))
+	assert.Equal(t, []int{10, 20, 30, 40}, nodePIDs(tree.Descendants(1)))
+	assert.Equal(t, []int{30, 10, 1}, nodePIDs(tree.Ancestors(40)))
+	assert.Empty(t, tree.Ancestors(1))
+	assert.Nil(t, tree.Ancestors(60))
+	assert.Nil(t, tree.Descendants(60))
+}
+
+func TestNewProcessTreeCycle(t *testing.T) {
+	// PID reuse during the scan can make processes appear to be each
+	// other's ancestors.
+	tree := NewProcessTree([]Process{
+		fakeProcess{pid: 1, ppid: 0},
+		fakeProcess{pid: 100, ppid: 300},
+		fakeProcess{pid: 200, ppid: 100},
+		fakeProcess{pid: 300, ppid: 200},
+		fakeProcess{pid: 400, ppid: 400},
+	})
+
+	assert.Equal(t, []int{1, 300, 400}, nodePIDs(tree.Roots))
+	assert.Equal(t, []int{100, 300}, nodePIDs(tree.Ancestors(200)))
+	assert.Equal(t, []int{100, 200}, nodePIDs(tree.Descendants(300)))
+	assert.Empty(t, tree.Descendants(400))
+}
+
+func TestNewProcessTreeParentPIDReused(t *testing.T) {
+	boot := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	tree := NewProcessTree([]Process{
+		fakeProcess{pid: 1, ppid: 0, start: boot},
+		fakeProcess{pid: 10, ppid: 1, start: boot.Add(2 * time.Second)},
+		// The original parent of 20 exited and its PID was reused by 10.
+		fakeProcess{pid: 20, ppid: 10, start: boot.Add(time.Second)},
+		fakeProcess{pid: 30, ppid: 10, start: boot.Add(3 * time.Second)},
+	})
+
+	assert.Equal(t, []int{1, 20}, nodePIDs(tree.Roots))
+	assert.Equal(t, []int{30}, nodePIDs(tree.Nodes[10].Children))
+}
+
+func TestNewProcessTreeLineage(t *testing.T) {
+	tree := NewProcessTree([]Process{
+		lineageProcess{fakeProcess: fakeProcess{pid: 1, ppid: 0}, startTicks: 1},
+		lineageProcess{fakeProcess: fakeProcess{pid: 10, ppid: 1}, startTicks: 300},
+		lineageProcess{fakeProcess: fakeProcess{pid: 20, ppid: 10}, startTicks: 200},
+		lineageProcess{fakeProcess: fakeProcess{pid: 30, ppid: 10}, startTicks: 400},
+	})
+
+	require.Len(t, tree.Nodes, 4)
+	assert.Equal(t, []int{1, 20}, nodePIDs(tree.Roots))
+	assert.Equal(t, []int{10}, nodePIDs(tree.Nodes[1].Children))
+	assert.Equal(t, []int{30}, nodePIDs(tree.Nodes[10].Children))
+}
@@ -0,0 +1,235 @@
+// Licensed to Elasticsearch B.V. under one or more contributor
+// license agreements. See the NOTICE file distributed with
+// this work for additional information regarding copyright
+// ownership. Elasticsearch B.V. licenses this file to you under
+// the Apache License, Version 2.0 (the "License"); you may
+// not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+
+package types
+
+import "strings"
+
+const (
+	redactedPrefix = "[REDACTED:"
+	redactedSuffix = "]"
+
+	// RedactionRuleURLUserinfo is the rule recorded for credentials masked in
+	// the userinfo of a URL.
+	RedactionRuleURLUserinfo = "url-userinfo"
+)
+
+// RedactionConfig configures which process arguments and environment
+// variables are masked before they are returned. Patterns are matched case
+// insensitively and may contain the wildcards '*' (any sequence of
+// characters) and '?' (any single character).
+type RedactionConfig struct {
+	// KeyPatterns match environment variable names and the keys of KEY=VALUE
+	// arguments, e.g. *PASSWORD*.
+	KeyPatterns []string `json:"key_patterns,omitempty"`
+
+	// FlagPatterns match command line flags, e.g. --*password*. The value is
+	// masked in both the --flag=value and the --flag value forms.
+	FlagPatterns []string `json:"flag_patterns,omitempty"`
+
+	// URLUserinfo masks the password of URLs with userinfo, or the user when
+	// there is no password (scheme://token@host).
+	URLUserinfo bool `json:"url_userinfo"`
+}
+
+// DefaultRedactionConfig returns a RedactionConfig matching common names for
+// passwords, secrets, tokens and keys.
+func DefaultRedactionConfig() RedactionConfig {
+	return RedactionConfig{
+		KeyPatterns: []string{
+			"*PASSWORD*", "*PASSWD*", "*SECRET*", "*TOKEN*",
+			"*API_KEY*", "*APIKEY*", "*PRIVATE_KEY*", "*CREDENTIAL*",
+		},
+		FlagPatterns: []string{
+			"-*password*", "-*passwd*", "-*secret*", "-*token*",
+			"-*api-key*", "-*apikey*", "-*private-key*",
+		},
+		URLUserinfo: true,
+	}
+}
+
+// Redactor masks secrets in process arguments and environment variables.
+// A masked value is replaced by a marker that records the rule that matched,
+// for example [REDACTED:key:*PASSWORD*]. Use RedactedBy to read the rule
+// from a value. A nil Redactor returns all values unchanged.
+type Redactor struct {
+	keyPatterns  []string
+	flagPatterns []string
+	urlUserinfo  bool
+}
+
+// NewRedactor returns a Redactor for the given configuration.
+func NewRedactor(config RedactionConfig) *Redactor {
+	return &Redactor{
+		keyPatterns:  append([]string(nil), config.KeyPatterns...),
+		flagPatterns: append([]string(nil), config.FlagPatterns...),
+		urlUserinfo:  config.URLUserinfo,
+	}
+}
+
+// RedactedBy returns the rule recorded in value if any part of it was masked
+// by a Redactor.
+func RedactedBy(value string) (rule string, redacted bool) {
+	i := strings.Index(value, redactedPrefix)
+	if i < 0 {
+		return "", false
+	}
+	rest := value[i+len(redactedPrefix):]
+	j := strings.Index(rest, redactedSuffix)
+	if j < 0 {
+		return "", false
+	}
+	return rest[:j], true
+}
+
+// Args returns a copy of args with secrets masked. A flag that matches a flag
+// pattern without an inline value causes the following argument to be
+// masked, unless that argument is itself a flag.
+func (r *Redactor) Args(args []string) []string {
+	if r == nil || args == nil {
+		return args
+	}
+
+	out := make([]string, len(args))
+	var pendingRule string
+	for i, arg := range args {
+		if pendingRule != "" && !strings.HasPrefix(arg, "-") {
+			out[i] = redactedValue(pendingRule)
+			pendingRule = ""
+			continue
+		}
+		pendingRule = ""
+
+		if strings.HasPrefix(arg, "-") {
+			name, value, hasValue := strings.Cut(arg, "=")
+			if rule, ok := r.match("flag:", r.flagPatterns, name); ok {
+				if hasValue {
+					out[i] = name + "=" + redactedValue(rule)
+				} else {
+					out[i] = arg
+					pendingRule = rule
+				}
+				continue
+			}
+			if hasValue {
+				out[i] = name + "=" + r.redactURLs(value)
+				continue
+			}
+		} else if key, _, ok := strings.Cut(arg, "="); ok {
+			if rule, ok := r.match("key:", r.keyPatterns, key); ok {
+				out[i] = key + "=" + redactedValue(rule)
+				continue
+			}
+		}
+
+		out[i] = r.redactURLs(arg)
+	}
+	return out
+}
+
+// Environment returns a copy of env with secrets masked.
+func (r *Redactor) Environment(env map[string]string) map[string]string {
+	if r == nil || env == nil {
+		return env
+	}
+
+	out := make(map[string]string, len(env))
+	for k, v := range env {
+		if rule, ok := r.match("key:", r.keyPatterns, k); ok {
+			out[k] = redactedValue(rule)
+			continue
+		}
+		out[k] = r.redactURLs(v)
+	}
+	return out
+}
+
+// match returns the rule of the first pattern matching s.
+func (r *Redactor) match(kind string, patterns []string, s string) (string, bool) {
+	s = strings.ToUpper(s)
+	for _, pattern := range patterns {
+		if matchWildcard(strings.ToUpper(pattern), s) {
+			return kind + pattern, true
+		}
+	}
+	return "", false
+}
+
+// redactURLs masks the credentials in the userinfo of all URLs in s.
+func (r *Redactor) redactURLs(s string) string {
+	if !r.urlUserinfo || !strings.Contains(s, "://") {
+		return s
+	}
+
+	var b strings.Builder
+	for {
+		i := strings.Index(s, "://")
+		if i < 0 {
+			break
+		}
+		i += len("://")
+		b.WriteString(s[:i])
+		s = s[i:]
+
+		authority := s
+		if end := strings.IndexAny(s, "/?# \t\n'\""); end >= 0 {
+			authority = s[:end]
+		}
+		at := strings.LastIndex(authority, "@")
+		if at < 0 {
+			continue
+		}
+		userinfo := authority[:at]
+		if user, _, ok := strings.Cut(userinfo, ":"); ok {
+			b.WriteString(user + ":")
+		}
+		b.WriteString(redactedValue(RedactionRuleURLUserinfo))
+		s = s[at:]
+	}
+	b.WriteString(s)
+	return b.String()
+}
+
+func redactedValue(rule string) string {
+	return redactedPrefix + rule + redactedSuffix
+}
+
+// matchWildcard reports whether s matches pattern, where '*' matches any
+// sequence of characters and '?' matches any single character.
+func matchWildcard(pattern, s string) bool {
+	starP, starS := -1, 0
+	p, i := 0, 0
+	for i < len(s) {
+		switch {
+		case p < len(pattern) && (pattern[p] == '?' || pattern[p] == s[i]):
+			p++
+			i++
+		case p < len(pattern) && pattern[p] == '*':
+			starP, starS = p, i
+			p++
+		case starP >= 0:
+			starS++
+			p, i = starP+1, starS
+		default:
+			return false
+		}
+	}
+	for p < len(pattern) && pattern[p] == '*' {
+		p++
+	}
+	return p == len(pattern)
+}